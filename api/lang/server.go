@@ -19,6 +19,8 @@ import (
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/execution"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/plugin"
 	"github.com/sourcegraph/jsonrpc2"
 )
 
@@ -301,6 +303,12 @@ func initializeRunner() error {
 
 func Start(p infoProvider, logLevel string) {
 	provider = p
+	if m, err := manifest.ProjectManifest(); err == nil {
+		// the commands of the special params are not run, as the specs are parsed on every change.
+		for _, w := range plugin.RegisterSpecialParamTypes(m) {
+			logDebug(nil, "%s", w)
+		}
+	}
 	provider.Init()
 	err := initializeRunner()
 	ctx, conn := startLsp(logLevel)
@@ -9,6 +9,7 @@ package cmd
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution"
@@ -148,9 +149,14 @@ func initPackageFlags() {
 	execution.TagsToFilterForParallelRun = tagsToFilterForParallelRun
 	execution.Verbose = verbose
 	execution.Strategy = strategy
+	execution.ParallelGranularity = granularity
 	filter.ExecuteTags = tags
 	order.SortOrder = sort
 	order.RandomSeed = randomSeed
+	order.Ranking = nil
+	if sortRanking != "" {
+		order.Ranking = strings.Split(sortRanking, ",")
+	}
 	filter.Distribute = group
 	filter.NumberOfExecutionStreams = streams
 	reporter.NumberOfExecutionStreams = streams
@@ -160,6 +166,16 @@ func initPackageFlags() {
 	}
 	filter.ScenariosName = scenarios
 	execution.MaxRetriesCount = maxRetriesCount
+	execution.MaxFailures = maxFailures
+	execution.TimeBudget = timeBudget
+	execution.MaxRunnerRestarts = runnerRestarts
+	execution.RepeatCount = repeatCount
+	execution.DryRun = dryRun
+	execution.Resume = resume
+	execution.ChangedSince = changedSince
+	execution.Coordinator = coordinator
+	execution.EventsAddress = eventsAddr
+	execution.EventsAllowRemote = eventsAllowRemote
 	execution.RetryOnlyTags = retryOnlyTags
 }
 
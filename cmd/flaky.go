@@ -0,0 +1,73 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/history"
+	"github.com/getgauge/gauge/logger"
+	"github.com/spf13/cobra"
+)
+
+var flakyCmd = &cobra.Command{
+	Use:   "flaky [flags]",
+	Short: "Lists the flaky scenarios from the execution history",
+	Long: `Lists the scenarios whose outcome alternated between passing and failing across the recorded runs, or which passed only after a retry.
+The outcome of each scenario is recorded in .gauge/history.json on every run.`,
+	Example: "  gauge flaky",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.SetProjectRoot(args); err != nil {
+			exit(err, cmd.UsageString())
+		}
+		loadEnvAndReinitLogger(cmd)
+		flaky := history.FlakyScenarios()
+		if machineReadable {
+			s, err := json.MarshalIndent(flaky, "", "\t")
+			if err != nil {
+				logger.Fatalf(true, "Failed to convert flaky scenarios to JSON. %s", err.Error())
+			}
+			fmt.Println(string(s))
+			return
+		}
+		if len(flaky) == 0 {
+			logger.Info(true, "No flaky scenarios found.")
+			return
+		}
+		for _, s := range flaky {
+			logger.Info(true, flakyScenarioText(s))
+		}
+	},
+	DisableAutoGenTag: true,
+}
+
+func init() {
+	GaugeCmd.AddCommand(flakyCmd)
+}
+
+func flakyScenarioText(s *history.Scenario) string {
+	text := fmt.Sprintf("%s:%d %s", s.Spec, s.Line, s.Heading)
+	if s.SpecRow > 0 {
+		text += fmt.Sprintf(" [spec row %d]", s.SpecRow)
+	}
+	if s.ScenarioRow > 0 {
+		text += fmt.Sprintf(" [scenario row %d]", s.ScenarioRow)
+	}
+	text += fmt.Sprintf("\n  failed in %d of %d runs", s.Failures(), len(s.Runs))
+	if n := s.Flips(); n > 0 {
+		text += fmt.Sprintf(", outcome changed %d times", n)
+	}
+	if n := s.PassedAfterRetry(); n > 0 {
+		text += fmt.Sprintf(", passed after a retry in %d runs", n)
+	}
+	if n := s.DistinctErrors(); n > 1 {
+		text += fmt.Sprintf(", %d distinct errors", n)
+	}
+	return text
+}
@@ -0,0 +1,39 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/execution/history"
+)
+
+func TestFlakyScenarioText(t *testing.T) {
+	s := &history.Scenario{Spec: "specs/login.spec", Heading: "Login", Line: 7, Runs: []*history.Outcome{
+		{RetriesCount: 1},
+		{Failed: true, RetriesCount: 1, ErrorHash: "a"},
+		{RetriesCount: 2},
+		{Failed: true, RetriesCount: 1, ErrorHash: "b"},
+	}}
+
+	want := "specs/login.spec:7 Login\n  failed in 2 of 4 runs, outcome changed 3 times, passed after a retry in 1 runs, 2 distinct errors"
+	if got := flakyScenarioText(s); got != want {
+		t.Errorf("Want: %q\n\tGot: %q", want, got)
+	}
+}
+
+func TestFlakyScenarioTextOfDataTableRow(t *testing.T) {
+	s := &history.Scenario{Spec: "a.spec", Heading: "Checkout", Line: 3, SpecRow: 2, ScenarioRow: 1, Runs: []*history.Outcome{
+		{RetriesCount: 2},
+		{RetriesCount: 1},
+	}}
+
+	want := "a.spec:3 Checkout [spec row 2] [scenario row 1]\n  failed in 0 of 2 runs, passed after a retry in 1 runs"
+	if got := flakyScenarioText(s); got != want {
+		t.Errorf("Want: %q\n\tGot: %q", want, got)
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution"
 	"github.com/getgauge/gauge/execution/rerun"
+	"github.com/getgauge/gauge/execution/timing"
+	"github.com/getgauge/gauge/filter"
 	gauge "github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/order"
@@ -35,17 +37,31 @@ const (
 	parallelDefault        = false
 	sortDefault            = ""
 	randomSeedDefault      = int64(0)
+	sortRankingDefault     = ""
 	installPluginsDefault  = true
 	environmentDefault     = "default"
 	tagsDefault            = ""
 	rowsDefault            = ""
 	strategyDefault        = "lazy"
+	granularityDefault     = "spec"
 	onlyDefault            = ""
 	groupDefault           = -1
 	maxRetriesCountDefault = 1
+	maxFailuresDefault     = 0
+	timeBudgetDefault      = time.Duration(0)
+	runnerRestartsDefault  = 3
+	repeatCountDefault     = 1
 	retryOnlyTagsDefault   = ""
 	failSafeDefault        = false
 	skipCommandSaveDefault = false
+	watchDefault           = false
+	dryRunDefault          = false
+	resumeDefault          = false
+	changedSinceDefault    = ""
+	coordinatorDefault     = ""
+	eventsAddrDefault      = ""
+	eventsRemoteDefault    = false
+	timingsFileDefault     = ""
 
 	verboseName         = "verbose"
 	simpleConsoleName   = "simple-console"
@@ -54,19 +70,33 @@ const (
 	parallelName        = "parallel"
 	sortName            = "sort"
 	randomSeedName      = "random-seed"
+	sortRankingName     = "sort-ranking"
 	installPluginsName  = "install-plugins"
 	environmentName     = "env"
 	tagsName            = "tags"
 	rowsName            = "table-rows"
 	strategyName        = "strategy"
+	granularityName     = "parallel-granularity"
 	groupName           = "group"
 	maxRetriesCountName = "max-retries-count"
+	maxFailuresName     = "max-failures"
+	timeBudgetName      = "time-budget"
+	runnerRestartsName  = "max-runner-restarts"
+	repeatCountName     = "repeat-count"
 	retryOnlyTagsName   = "retry-only"
 	streamsName         = "n"
 	onlyName            = "only"
 	failSafeName        = "fail-safe"
 	skipCommandSaveName = "skip-save"
 	scenarioName        = "scenario"
+	watchName           = "watch"
+	dryRunName          = "dry-run"
+	resumeName          = "resume"
+	changedSinceName    = "changed-since"
+	coordinatorName     = "coordinator"
+	eventsAddrName      = "events-addr"
+	eventsRemoteName    = "events-allow-remote"
+	timingsFileName     = "timings-file"
 )
 
 var overrideRerunFlags = []string{verboseName, simpleConsoleName, machineReadableName, dirName, logLevelName}
@@ -105,20 +135,34 @@ var (
 	parallel                   bool
 	sort                       string
 	randomSeed                 int64
+	sortRanking                string
 	installPlugins             bool
 	environment                string
 	tags                       string
 	tagsToFilterForParallelRun string
 	rows                       string
 	strategy                   string
+	granularity                string
 	streams                    int
 	maxRetriesCount            int
+	maxFailures                int
+	timeBudget                 time.Duration
+	runnerRestarts             int
+	repeatCount                int
 	retryOnlyTags              string
 	group                      int
 	failSafe                   bool
 	skipCommandSave            bool
 	scenarios                  []string
 	scenarioNameDefault        []string
+	watch                      bool
+	dryRun                     bool
+	resume                     bool
+	changedSince               string
+	coordinator                string
+	eventsAddr                 string
+	eventsAllowRemote          bool
+	timingsFile                string
 )
 
 func init() {
@@ -128,10 +172,14 @@ func init() {
 	f.BoolVarP(&simpleConsole, simpleConsoleName, "", simpleConsoleDefault, "Removes colouring and simplifies the console output")
 	f.StringVarP(&environment, environmentName, "e", environmentDefault, "Specifies the environment to use")
 	f.StringVarP(&tags, tagsName, "t", tagsDefault, "Executes the specs and scenarios tagged with given tags")
-	f.StringVarP(&rows, rowsName, "r", rowsDefault, "Executes the specs and scenarios only for the selected rows. It can be specified by range as 2-4 or as list 2,4")
+	f.StringVarP(&rows, rowsName, "r", rowsDefault, "Executes the specs and scenarios only for the selected rows. It can be specified by range as 2-4, as list 2,4 or as a filter on column values as \"country=IN && tier!=gold\"")
 	f.BoolVarP(&parallel, parallelName, "p", parallelDefault, "Execute specs in parallel")
 	f.IntVarP(&streams, streamsName, "n", streamsDefault, "Specify number of parallel execution streams")
 	f.IntVarP(&maxRetriesCount, maxRetriesCountName, "c", maxRetriesCountDefault, "Max count of iterations for failed scenario")
+	f.IntVarP(&maxFailures, maxFailuresName, "", maxFailuresDefault, "Stop executing new specs once the given number of scenarios have failed. 0 means no limit")
+	f.DurationVarP(&timeBudget, timeBudgetName, "", timeBudgetDefault, "Stop executing new specs and scenarios once the given time, like 20m, has elapsed, and report the rest as skipped. 0 means no limit")
+	f.IntVarP(&runnerRestarts, runnerRestartsName, "", runnerRestartsDefault, "Max number of times a runner is restarted after crashing or timing out, to continue with the next scenarios")
+	f.IntVarP(&repeatCount, repeatCountName, "", repeatCountDefault, "Execute the specs the given number of times, and report the pass rate, duration and distinct failures of each scenario across the runs")
 	f.StringVarP(&retryOnlyTags, retryOnlyTagsName, "", retryOnlyTagsDefault, "Retries the specs and scenarios tagged with given tags")
 	f.StringVarP(&tagsToFilterForParallelRun, onlyName, "o", onlyDefault, "Execute only the specs and scenarios tagged with given tags in parallel, rest will be run in serial. Applicable only if run in parallel.")
 	err := f.MarkHidden(onlyName)
@@ -139,11 +187,14 @@ func init() {
 		logger.Errorf(false, "Unable to mark '%s' flag as hidden: %s", onlyName, err.Error())
 	}
 	f.IntVarP(&group, groupName, "g", groupDefault, "Specify which group of specification to execute based on -n flag")
+	f.StringVarP(&timingsFile, timingsFileName, "", timingsFileDefault, "Distribute the specs among the groups of -g by the execution times in the given timings file, like a .gauge/timings.json shared by all the machines. Specs are distributed by count otherwise")
 	f.StringVarP(&strategy, strategyName, "", strategyDefault, "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`")
-	f.StringVarP(&sort, sortName, "s", sortDefault, "Set the order of spec execution. Possible options are: `alpha`, `random`")
+	f.StringVarP(&granularity, granularityName, "", granularityDefault, "Set the unit of work distributed among parallel streams. Possible options are: `spec`, `scenario`")
+	f.StringVarP(&sort, sortName, "s", sortDefault, "Set the order of spec execution. Possible options are: `alpha`, `random`, `failed-first`, `duration-desc`")
 	// Set NoOptDefVal to "alpha" for backward compatibility: -s without value = alphabetical sort
 	f.Lookup(sortName).NoOptDefVal = "alpha"
 	f.Int64Var(&randomSeed, randomSeedName, randomSeedDefault, "Random seed for reproducible random execution. Used only when --sort=random")
+	f.StringVarP(&sortRanking, sortRankingName, "", sortRankingDefault, "Comma separated specs to be executed first, in the given order. Used only when --sort=failed-first or --sort=duration-desc")
 	f.BoolVarP(&installPlugins, installPluginsName, "i", installPluginsDefault, "Install All Missing Plugins")
 	f.BoolVarP(&failed, failedName, "f", failedDefault, "Run only the scenarios failed in previous run. This cannot be used in conjunction with any other argument")
 	f.BoolVarP(&repeat, repeatName, "", repeatDefault, "Repeat last run. This cannot be used in conjunction with any other argument")
@@ -154,8 +205,19 @@ func init() {
 	if err != nil {
 		logger.Errorf(false, "Unable to mark '%s' flag as hidden: %s", skipCommandSaveName, err.Error())
 	}
+	err = f.MarkHidden(sortRankingName)
+	if err != nil {
+		logger.Errorf(false, "Unable to mark '%s' flag as hidden: %s", sortRankingName, err.Error())
+	}
 
 	f.StringArrayVar(&scenarios, scenarioName, scenarioNameDefault, "Set scenarios for running specs with scenario name")
+	f.BoolVarP(&dryRun, dryRunName, "", dryRunDefault, "Print the specs, scenarios and resolved steps to be executed by each stream, without executing them")
+	f.BoolVarP(&resume, resumeName, "", resumeDefault, "Skip the specs completed in the last run, which was interrupted, and report their results along with the results of this run")
+	f.StringVarP(&changedSince, changedSinceName, "", changedSinceDefault, "Execute only the specs affected by the changes in the working tree since the given git ref")
+	f.BoolVarP(&watch, watchName, "", watchDefault, "Keep watching specs, concepts and step implementations, and re-run the affected scenarios on every change")
+	f.StringVarP(&coordinator, coordinatorName, "", coordinatorDefault, "Listen at the given address, like :4000, for workers started with `gauge worker` and hand out the specs to them. Listens on loopback if no host is given. Other hosts need a token shared with the workers in GAUGE_WORKER_TOKEN")
+	f.StringVarP(&eventsAddr, eventsAddrName, "", eventsAddrDefault, "Stream the execution events live at the given local address, like 127.0.0.1:9000, to subscribers of /events as JSON, or as protobuf with ?format=proto. Streams on loopback if no host is given")
+	f.BoolVarP(&eventsAllowRemote, eventsRemoteName, "", eventsRemoteDefault, "Allow streaming the execution events at an address reachable from other hosts, like 0.0.0.0:9000")
 }
 
 func executeFailed(cmd *cobra.Command) {
@@ -243,6 +305,14 @@ func execute(cmd *cobra.Command, args []string) {
 	}
 	specs := getSpecsDir(args)
 	rerun.SaveState(os.Args[1:], specs)
+	filter.GroupDurations = nil
+	if timingsFile != "" {
+		durations, err := timing.ReadSpecDurations(timingsFile)
+		if err != nil {
+			exit(err, "")
+		}
+		filter.GroupDurations = durations
+	}
 
 	// Save command args with auto-generated seed if needed
 	cmdArgsToSave := os.Args
@@ -253,11 +323,22 @@ func execute(cmd *cobra.Command, args []string) {
 		// Append the seed to the command args for saving
 		cmdArgsToSave = append(os.Args, fmt.Sprintf("--%s=%d", randomSeedName, randomSeed))
 	}
+	if order.IsRanked(sort) && sortRanking == "" {
+		// Rank the specs now so that the order can be reproduced by reruns, even after failures and timings change
+		order.Ranking = execution.RankSpecs(sort)
+		if len(order.Ranking) > 0 {
+			sortRanking = strings.Join(order.Ranking, ",")
+			cmdArgsToSave = append(cmdArgsToSave, fmt.Sprintf("--%s=%s", sortRankingName, sortRanking))
+		}
+	}
 
-	if !skipCommandSave {
+	if !skipCommandSave && !dryRun {
 		rerun.WritePrevArgs(cmdArgsToSave)
 	}
 	installMissingPlugins(installPlugins, false)
+	if watch {
+		os.Exit(execution.Watch(specs))
+	}
 	exitCode := execution.ExecuteSpecs(specs)
 	if failSafe && exitCode != execution.ParseFailed {
 		exitCode = 0
@@ -291,6 +372,36 @@ func handleConflictingParams(setFlags *pflag.FlagSet, args []string) error {
 	if !parallel && tagsToFilterForParallelRun != "" {
 		return errors.New("Invalid Command. flag --only can be used only with --parallel")
 	}
+	if dryRun && watch {
+		return errors.New("Invalid Command. flag --dry-run cannot be used with --watch")
+	}
+	if resume && watch {
+		return errors.New("Invalid Command. flag --resume cannot be used with --watch")
+	}
+	if changedSince != "" && watch {
+		return errors.New("Invalid Command. flag --changed-since cannot be used with --watch")
+	}
+	if parallel && watch {
+		return errors.New("Invalid Command. flag --watch cannot be used with --parallel")
+	}
+	if coordinator != "" && parallel {
+		return errors.New("Invalid Command. flag --coordinator cannot be used with --parallel")
+	}
+	if coordinator != "" && watch {
+		return errors.New("Invalid Command. flag --coordinator cannot be used with --watch")
+	}
+	if repeatCount > 1 && watch {
+		return errors.New("Invalid Command. flag --repeat-count cannot be used with --watch")
+	}
+	if repeatCount > 1 && resume {
+		return errors.New("Invalid Command. flag --repeat-count cannot be used with --resume")
+	}
+	if repeatCount > 1 && coordinator != "" {
+		return errors.New("Invalid Command. flag --repeat-count cannot be used with --coordinator")
+	}
+	if !parallel && granularity != granularityDefault {
+		return errors.New("Invalid Command. flag --parallel-granularity can be used only with --parallel")
+	}
 	if maxRetriesCount == 1 && retryOnlyTags != "" {
 		return errors.New("Invalid Command. flag --retry-only can be used only with --max-retry-count")
 	}
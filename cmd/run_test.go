@@ -219,6 +219,54 @@ func TestHandleConflictingParamsWithJustRepeatFlag(t *testing.T) {
 	}
 }
 
+func TestHandleConflictingParamsWithWatchInParallel(t *testing.T) {
+	repeat, parallel, watch = false, true, true
+	defer func() { parallel, watch = false, false }()
+	expectedErrorMessage := "Invalid Command. flag --watch cannot be used with --parallel"
+
+	err := handleConflictingParams(&pflag.FlagSet{}, []string{})
+
+	if err == nil || err.Error() != expectedErrorMessage {
+		t.Errorf("Expected %v  Got %v", expectedErrorMessage, err)
+	}
+}
+
+func TestHandleConflictingParamsWithChangedSinceInWatch(t *testing.T) {
+	repeat, changedSince, watch = false, "main", true
+	defer func() { changedSince, watch = "", false }()
+	expectedErrorMessage := "Invalid Command. flag --changed-since cannot be used with --watch"
+
+	err := handleConflictingParams(&pflag.FlagSet{}, []string{})
+
+	if err == nil || err.Error() != expectedErrorMessage {
+		t.Errorf("Expected %v  Got %v", expectedErrorMessage, err)
+	}
+}
+
+func TestHandleConflictingParamsWithCoordinatorInParallel(t *testing.T) {
+	repeat, parallel, coordinator = false, true, ":4000"
+	defer func() { parallel, coordinator = false, "" }()
+	expectedErrorMessage := "Invalid Command. flag --coordinator cannot be used with --parallel"
+
+	err := handleConflictingParams(&pflag.FlagSet{}, []string{})
+
+	if err == nil || err.Error() != expectedErrorMessage {
+		t.Errorf("Expected %v  Got %v", expectedErrorMessage, err)
+	}
+}
+
+func TestHandleConflictingParamsWithRepeatCountInWatch(t *testing.T) {
+	repeat, repeatCount, watch = false, 10, true
+	defer func() { repeatCount, watch = 1, false }()
+	expectedErrorMessage := "Invalid Command. flag --repeat-count cannot be used with --watch"
+
+	err := handleConflictingParams(&pflag.FlagSet{}, []string{})
+
+	if err == nil || err.Error() != expectedErrorMessage {
+		t.Errorf("Expected %v  Got %v", expectedErrorMessage, err)
+	}
+}
+
 func TestHandleRerunFlagsWithVerbose(t *testing.T) {
 	if os.Getenv("TEST_EXITS") == "1" {
 		cmd := &cobra.Command{}
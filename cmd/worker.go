@@ -0,0 +1,51 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution"
+	"github.com/spf13/cobra"
+)
+
+const (
+	connectDefault = ""
+	connectName    = "connect"
+)
+
+var (
+	workerCmd = &cobra.Command{
+		Use:   "worker [flags]",
+		Short: "Execute the specs handed out by a coordinator",
+		Long: `Execute the specs handed out by a coordinator started with gauge run --coordinator, until all the specs are executed.
+Set GAUGE_WORKER_TOKEN to the token of the coordinator, when it listens at an address reachable from other hosts.`,
+		Example: `  gauge worker --connect localhost:4000
+  GAUGE_WORKER_TOKEN=secret gauge worker --connect 10.0.0.5:4000 --env ci`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if connect == "" {
+				exit(errors.New("Invalid Command. flag --connect is required"), cmd.UsageString())
+			}
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			os.Exit(execution.Work(connect))
+		},
+		DisableAutoGenTag: true,
+	}
+	connect string
+)
+
+func init() {
+	GaugeCmd.AddCommand(workerCmd)
+	flags := workerCmd.Flags()
+	flags.StringVarP(&connect, connectName, "", connectDefault, "Address of the coordinator, like localhost:4000")
+	flags.StringVarP(&environment, environmentName, "e", environmentDefault, "Specifies the environment to use")
+}
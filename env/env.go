@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"time"
 
 	"strings"
 
@@ -51,6 +52,10 @@ const (
 	gaugeSpecFileExtensions = "gauge_spec_file_extensions"
 	gaugeDataDir            = "gauge_data_dir"
 	envDirEnvVar            = "gauge_env_dir"
+	stepTimeout             = "step_timeout"
+	scenarioTimeout         = "scenario_timeout"
+	gaugeSecretKeys         = "gauge_secret_keys"
+	maskedValue             = "******"
 )
 
 var envVars map[string]string
@@ -281,6 +286,19 @@ func isPropertySet(property string) bool {
 	return len(os.Getenv(property)) > 0
 }
 
+// Property gets the value of a property loaded from the env directories, or set by default, by LoadEnv.
+// An env variable of the same name takes precedence over the value in the properties file.
+func Property(key string) (string, bool) {
+	value, ok := envVars[key]
+	if !ok {
+		return "", false
+	}
+	if isPropertySet(key) {
+		return os.Getenv(key), true
+	}
+	return value, true
+}
+
 // comma-separated value of environments
 func CurrentEnvironments() string {
 	if len(currentEnvironments) == 0 {
@@ -300,6 +318,35 @@ func convertToBool(property string, defaultValue bool) bool {
 	return boolValue
 }
 
+func convertToDuration(property string) time.Duration {
+	v := strings.TrimSpace(os.Getenv(property))
+	if v == "" {
+		return 0
+	}
+	d, err := ParseDuration(v)
+	if err != nil {
+		logger.Warningf(true, "Incorrect value for %s in property file. Cannot convert %s to duration.", property, v)
+		return 0
+	}
+	return d
+}
+
+// ParseDuration parses durations such as 30s or 2m. A plain number is read as milliseconds.
+func ParseDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	d, err := time.ParseDuration(value)
+	if ms, e := strconv.Atoi(value); e == nil {
+		d, err = time.Millisecond*time.Duration(ms), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("negative duration %s", value)
+	}
+	return d, nil
+}
+
 // AllowFilteredParallelExecution - feature toggle for filtered parallel execution
 var AllowFilteredParallelExecution = func() bool {
 	return convertToBool(allowFilteredParallelExecution, false)
@@ -342,6 +389,38 @@ var AllowCaseSensitiveTags = func() bool {
 	return convertToBool(allowCaseSensitiveTags, false)
 }
 
+// StepTimeout gets the maximum time a step may take to execute. Zero means no limit.
+var StepTimeout = func() time.Duration {
+	return convertToDuration(stepTimeout)
+}
+
+// ScenarioTimeout gets the maximum time a scenario may take to execute. Zero means no limit.
+var ScenarioTimeout = func() time.Duration {
+	return convertToDuration(scenarioTimeout)
+}
+
+// SecretValues gets the values of the properties and env variables whose keys are listed in gauge_secret_keys,
+// so that they can be masked in console output.
+var SecretValues = func() []string {
+	var values []string
+	for _, key := range strings.Split(os.Getenv(gaugeSecretKeys), ",") {
+		if v := os.Getenv(strings.TrimSpace(key)); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// MaskSecret hides the value if it is the value of a secret property, so that it is not shown in the console or streamed.
+func MaskSecret(value string) string {
+	for _, secret := range SecretValues() {
+		if value == secret {
+			return maskedValue
+		}
+	}
+	return value
+}
+
 // GaugeDataDir gets the data files location. This location should be relative to GAUGE_PROJECT_ROOT
 var GaugeDataDir = func() string {
 	d := os.Getenv(gaugeDataDir)
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
@@ -452,3 +453,54 @@ func (s *MySuite) TestLoadEnvWithAcyclicProperties(c *C) {
 	c.Assert(os.Getenv("e"), Equals, "foo")
 	c.Assert(os.Getenv("f"), Equals, "foo")
 }
+
+func (s *MySuite) TestParseDuration(c *C) {
+	d, err := ParseDuration("30s")
+	c.Assert(err, Equals, nil)
+	c.Assert(d, Equals, 30*time.Second)
+
+	d, err = ParseDuration(" 1500 ")
+	c.Assert(err, Equals, nil)
+	c.Assert(d, Equals, 1500*time.Millisecond)
+
+	_, err = ParseDuration("-1s")
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestPropertyLoadedFromEnv(c *C) {
+	os.Clearenv()
+	config.ProjectRoot = "_testdata/proj1"
+
+	e := LoadEnv(common.DefaultEnvDir, nil)
+	c.Assert(e, Equals, nil)
+
+	value, ok := Property("property1")
+	c.Assert(ok, Equals, true)
+	c.Assert(value, Equals, "value1")
+
+	_, ok = Property("property2")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *MySuite) TestPropertyOverriddenByEnvVariable(c *C) {
+	os.Clearenv()
+	_ = os.Setenv("property1", "fromShell")
+	config.ProjectRoot = "_testdata/proj1"
+
+	e := LoadEnv(common.DefaultEnvDir, nil)
+	c.Assert(e, Equals, nil)
+
+	value, ok := Property("property1")
+	c.Assert(ok, Equals, true)
+	c.Assert(value, Equals, "fromShell")
+}
+
+func (s *MySuite) TestSecretValues(c *C) {
+	os.Clearenv()
+	_ = os.Setenv("gauge_secret_keys", "password, api_token, unset_key")
+	_ = os.Setenv("password", "s3cret")
+	_ = os.Setenv("api_token", "t0ken")
+	_ = os.Setenv("user", "alice")
+
+	c.Assert(SecretValues(), DeepEquals, []string{"s3cret", "t0ken"})
+}
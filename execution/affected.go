@@ -0,0 +1,123 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/runner"
+)
+
+// conceptsInFiles returns the step values of the concepts defined in the given files,
+// along with the step values of all the concepts which use them directly or through other concepts.
+func conceptsInFiles(dict *gauge.ConceptDictionary, files map[string]bool) map[string]bool {
+	values := make(map[string]bool)
+	for value, concept := range dict.ConceptsMap {
+		if files[filepath.Clean(concept.FileName)] {
+			values[value] = true
+		}
+	}
+	return withDependentConcepts(dict, values)
+}
+
+func withDependentConcepts(dict *gauge.ConceptDictionary, values map[string]bool) map[string]bool {
+	for added := true; added; {
+		added = false
+		for value, concept := range dict.ConceptsMap {
+			if !values[value] && usesAnyStep(concept.ConceptStep.ConceptSteps, values) {
+				values[value] = true
+				added = true
+			}
+		}
+	}
+	return values
+}
+
+// usesAnyStep tells if any of the steps, or the steps of the concepts among them, have one of the given step values.
+func usesAnyStep(steps []*gauge.Step, values map[string]bool) bool {
+	for _, step := range steps {
+		if values[step.Value] || usesAnyStep(step.ConceptSteps, values) {
+			return true
+		}
+	}
+	return false
+}
+
+// scenariosUsingSteps returns the specs and scenarios, in the format accepted by gauge run, which use any of the given step values.
+// A spec whose contexts or teardowns use one of the steps is returned as a whole.
+func scenariosUsingSteps(specs []*gauge.Specification, values map[string]bool) (items []string) {
+	if len(values) == 0 {
+		return
+	}
+	for _, spec := range specs {
+		if usesAnyStep(spec.Contexts, values) || usesAnyStep(spec.TearDownSteps, values) {
+			items = append(items, spec.FileName)
+			continue
+		}
+		for _, scn := range spec.Scenarios {
+			if usesAnyStep(scn.Steps, values) {
+				items = append(items, scenarioRef(spec.FileName, scn))
+			}
+		}
+	}
+	return
+}
+
+func scenarioRef(fileName string, scn *gauge.Scenario) string {
+	return fmt.Sprintf("%s:%d", fileName, scn.Span.Start)
+}
+
+// implementedSteps asks the runner for the step values implemented in the given file.
+func implementedSteps(r runner.Runner, file string) (map[string]bool, error) {
+	m := &gauge_messages.Message{MessageType: gauge_messages.Message_StepPositionsRequest, StepPositionsRequest: &gauge_messages.StepPositionsRequest{FilePath: file}}
+	response, err := r.ExecuteMessageWithTimeout(m)
+	if err != nil {
+		return nil, err
+	}
+	if e := response.GetStepPositionsResponse().GetError(); e != "" {
+		return nil, fmt.Errorf("%s", e)
+	}
+	values := make(map[string]bool)
+	for _, p := range response.GetStepPositionsResponse().GetStepPositions() {
+		values[p.GetStepValue()] = true
+	}
+	logger.Debugf(true, "Found %d step implementations in %s", len(values), file)
+	return values, nil
+}
+
+// changedImplementationFiles filters the implementation files among the changed files.
+// When the runner cannot list its implementation files, any file other than specs and concepts is considered to be one.
+func changedImplementationFiles(implFiles map[string]bool, files map[string]bool) (changed []string) {
+	extensions := make(map[string]bool)
+	for f := range implFiles {
+		extensions[filepath.Ext(f)] = true
+	}
+	for f := range files {
+		if implFiles == nil || implFiles[f] || extensions[filepath.Ext(f)] {
+			changed = append(changed, f)
+		}
+	}
+	return
+}
+
+// implementationFiles asks the runner for the files containing step implementations.
+func implementationFiles(r runner.Runner) (map[string]bool, error) {
+	m := &gauge_messages.Message{MessageType: gauge_messages.Message_ImplementationFileListRequest, ImplementationFileListRequest: &gauge_messages.ImplementationFileListRequest{}}
+	response, err := r.ExecuteMessageWithTimeout(m)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]bool)
+	for _, f := range response.GetImplementationFileListResponse().GetImplementationFilePaths() {
+		files[filepath.Clean(f)] = true
+	}
+	return files, nil
+}
@@ -0,0 +1,63 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func conceptDictionary() *gauge.ConceptDictionary {
+	dict := gauge.NewConceptDictionary()
+	login := &gauge.Step{Value: "login as {}", IsConcept: true, ConceptSteps: []*gauge.Step{{Value: "open login page"}, {Value: "enter user {}"}}}
+	checkout := &gauge.Step{Value: "checkout", IsConcept: true, ConceptSteps: []*gauge.Step{login, {Value: "pay"}}}
+	search := &gauge.Step{Value: "search {}", IsConcept: true, ConceptSteps: []*gauge.Step{{Value: "type {}"}}}
+	dict.ConceptsMap[login.Value] = &gauge.Concept{ConceptStep: login, FileName: "/project/specs/login.cpt"}
+	dict.ConceptsMap[checkout.Value] = &gauge.Concept{ConceptStep: checkout, FileName: "/project/specs/shop.cpt"}
+	dict.ConceptsMap[search.Value] = &gauge.Concept{ConceptStep: search, FileName: "/project/specs/shop.cpt"}
+	return dict
+}
+
+func TestConceptsInFilesIncludesConceptsUsingThem(t *testing.T) {
+	got := conceptsInFiles(conceptDictionary(), map[string]bool{"/project/specs/login.cpt": true})
+
+	want := map[string]bool{"login as {}": true, "checkout": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
+
+func TestWithDependentConceptsForImplementedSteps(t *testing.T) {
+	got := withDependentConcepts(conceptDictionary(), map[string]bool{"enter user {}": true})
+
+	want := map[string]bool{"enter user {}": true, "login as {}": true, "checkout": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
+
+func TestScenariosUsingSteps(t *testing.T) {
+	dict := conceptDictionary()
+	checkout := dict.ConceptsMap["checkout"].ConceptStep
+	search := dict.ConceptsMap["search {}"].ConceptStep
+	spec1 := &gauge.Specification{FileName: "one.spec", Scenarios: []*gauge.Scenario{
+		{Span: &gauge.Span{Start: 3}, Steps: []*gauge.Step{checkout}},
+		{Span: &gauge.Span{Start: 7}, Steps: []*gauge.Step{search}},
+	}}
+	spec2 := &gauge.Specification{FileName: "two.spec", Contexts: []*gauge.Step{{Value: "pay"}}, Scenarios: []*gauge.Scenario{
+		{Span: &gauge.Span{Start: 5}, Steps: []*gauge.Step{search}},
+	}}
+
+	got := scenariosUsingSteps([]*gauge.Specification{spec1, spec2}, map[string]bool{"pay": true})
+
+	want := []string{"one.spec:3", "two.spec"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
@@ -0,0 +1,137 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+)
+
+// ChangedSince is the git ref against which the working tree is compared, to execute only the specs affected by the changes.
+var ChangedSince string
+
+// changedFiles returns the files of the project changed in the working tree since the given ref, including the untracked ones.
+func changedFiles(ref string) (map[string]bool, error) {
+	diff, err := git("diff", "--name-only", "--relative", ref, "--")
+	if err != nil {
+		return nil, err
+	}
+	untracked, err := git("ls-files", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]bool)
+	for _, f := range append(diff, untracked...) {
+		files[filepath.Join(config.ProjectRoot, filepath.FromSlash(f))] = true
+	}
+	return files, nil
+}
+
+func git(args ...string) ([]string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = config.ProjectRoot
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s failed. %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	var lines []string
+	for _, l := range strings.Split(string(out), "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines, nil
+}
+
+// specsChangedSince returns the specs affected by the changes since the given ref. A changed spec is affected by itself,
+// the others are affected if they use a concept from a changed concept file or a step implemented in a changed file.
+// All the specs are returned if the steps implemented in the changed files cannot be found out from the runner, or if
+// a changed file implements no steps, like a helper or a file of hooks, as it may be used by any of the specs.
+func specsChangedSince(ref string, specs *gauge.SpecCollection, r runner.Runner) (*gauge.SpecCollection, error) {
+	files, err := changedFiles(ref)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debugf(true, "Found %d files changed since %s", len(files), ref)
+	conceptFiles, otherFiles := make(map[string]bool), make(map[string]bool)
+	for f := range files {
+		switch {
+		case util.IsSpec(f):
+		case util.IsConcept(f):
+			conceptFiles[f] = true
+		default:
+			otherFiles[f] = true
+		}
+	}
+	steps := make(map[string]bool)
+	dict := gauge.NewConceptDictionary()
+	if len(conceptFiles) > 0 {
+		if dict, _, err = parser.ParseConcepts(); err != nil {
+			return nil, err
+		}
+		steps = conceptsInFiles(dict, conceptFiles)
+	}
+	implFiles, err := implementationFiles(r)
+	if err != nil {
+		logger.Debugf(true, "Unable to get implementation files from runner. %s", err.Error())
+		implFiles = nil
+	}
+	changedImplFiles := changedImplementationFiles(implFiles, otherFiles)
+	if implFiles == nil && len(changedImplFiles) > 0 {
+		logger.Infof(true, "Unable to find the step implementations among the changed files, executing all specs.")
+		return specs, nil
+	}
+	for _, f := range changedImplFiles {
+		implSteps, err := implementedSteps(r, f)
+		if err != nil {
+			logger.Infof(true, "Unable to get step implementations in %s, executing all specs. %s", f, err.Error())
+			return specs, nil
+		}
+		if len(implSteps) == 0 {
+			logger.Infof(true, "No step implementations found in %s, which may be used by any of them, executing all specs.", f)
+			return specs, nil
+		}
+		for v := range implSteps {
+			steps[v] = true
+		}
+	}
+	steps = withDependentConcepts(dict, steps)
+	var affected []*gauge.Specification
+	for _, spec := range specs.Specs() {
+		if files[filepath.Clean(spec.FileName)] || specUsesAnyStep(spec, steps) {
+			affected = append(affected, spec)
+		}
+	}
+	return gauge.NewSpecCollection(affected, false), nil
+}
+
+func specUsesAnyStep(spec *gauge.Specification, values map[string]bool) bool {
+	if len(values) == 0 {
+		return false
+	}
+	if usesAnyStep(spec.Contexts, values) || usesAnyStep(spec.TearDownSteps, values) {
+		return true
+	}
+	for _, scn := range spec.Scenarios {
+		if usesAnyStep(scn.Steps, values) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func runGit(t *testing.T, args ...string) {
+	cmd := exec.Command("git", append([]string{"-c", "user.name=gauge", "-c", "user.email=gauge@example.com"}, args...)...)
+	cmd.Dir = config.ProjectRoot
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed. %s", args, out)
+	}
+}
+
+func writeProjectFile(t *testing.T, name, content string) string {
+	file := filepath.Join(config.ProjectRoot, name)
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestSpecsChangedSinceSelectsChangedAndNewSpecs(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	config.ProjectRoot = t.TempDir()
+	a := writeProjectFile(t, "a.spec", "# A\n")
+	b := writeProjectFile(t, "b.spec", "# B\n")
+	runGit(t, "init", "-q")
+	runGit(t, "add", "-A")
+	runGit(t, "commit", "-q", "-m", "specs")
+	writeProjectFile(t, "a.spec", "# A changed\n")
+	c := writeProjectFile(t, "c.spec", "# C\n")
+	specs := gauge.NewSpecCollection([]*gauge.Specification{{FileName: a}, {FileName: b}, {FileName: c}}, false)
+
+	got, err := specsChangedSince("HEAD", specs, &mockRunner{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var files []string
+	for _, spec := range got.Specs() {
+		files = append(files, spec.FileName)
+	}
+	want := []string{a, c}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("Want: %v, Got: %v", want, files)
+	}
+}
+
+type unresponsiveRunner struct {
+	mockRunner
+}
+
+func (r *unresponsiveRunner) ExecuteMessageWithTimeout(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+	return nil, errors.New("timed out")
+}
+
+func TestSpecsChangedSinceSelectsAllSpecsWhenImplementationFilesAreUnknown(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	config.ProjectRoot = t.TempDir()
+	a := writeProjectFile(t, "a.spec", "# A\n")
+	b := writeProjectFile(t, "b.spec", "# B\n")
+	runGit(t, "init", "-q")
+	runGit(t, "add", "-A")
+	runGit(t, "commit", "-q", "-m", "specs")
+	writeProjectFile(t, "step_impl.js", "step(\"Say hello\", function () {});\n")
+	specs := gauge.NewSpecCollection([]*gauge.Specification{{FileName: a}, {FileName: b}}, false)
+
+	got, err := specsChangedSince("HEAD", specs, &unresponsiveRunner{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Size() != 2 {
+		t.Errorf("Expected all specs to be selected, got %d", got.Size())
+	}
+}
+
+// implementingRunner implements the steps in the given files.
+type implementingRunner struct {
+	mockRunner
+	steps map[string][]string
+}
+
+func (r *implementingRunner) ExecuteMessageWithTimeout(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+	if m.MessageType == gauge_messages.Message_ImplementationFileListRequest {
+		var files []string
+		for f := range r.steps {
+			files = append(files, f)
+		}
+		return &gauge_messages.Message{ImplementationFileListResponse: &gauge_messages.ImplementationFileListResponse{ImplementationFilePaths: files}}, nil
+	}
+	var positions []*gauge_messages.StepPositionsResponse_StepPosition
+	for _, v := range r.steps[m.GetStepPositionsRequest().GetFilePath()] {
+		positions = append(positions, &gauge_messages.StepPositionsResponse_StepPosition{StepValue: v})
+	}
+	return &gauge_messages.Message{StepPositionsResponse: &gauge_messages.StepPositionsResponse{StepPositions: positions}}, nil
+}
+
+func TestSpecsChangedSinceSelectsAllSpecsWhenChangedImplementationFileHasNoSteps(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	config.ProjectRoot = t.TempDir()
+	a := writeProjectFile(t, "a.spec", "# A\n")
+	b := writeProjectFile(t, "b.spec", "# B\n")
+	impl := writeProjectFile(t, "step_impl.js", "step(\"Say hello\", function () {});\n")
+	runGit(t, "init", "-q")
+	runGit(t, "add", "-A")
+	runGit(t, "commit", "-q", "-m", "specs")
+	helper := writeProjectFile(t, "page.js", "module.exports = {};\n")
+	specs := gauge.NewSpecCollection([]*gauge.Specification{{FileName: a}, {FileName: b}}, false)
+	r := &implementingRunner{steps: map[string][]string{impl: {"Say hello"}, helper: nil}}
+
+	got, err := specsChangedSince("HEAD", specs, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Size() != 2 {
+		t.Errorf("Expected all specs to be selected, got %d", got.Size())
+	}
+}
+
+func TestSpecUsesAnyStepThroughConcepts(t *testing.T) {
+	checkout := conceptDictionary().ConceptsMap["checkout"].ConceptStep
+	spec := &gauge.Specification{Scenarios: []*gauge.Scenario{{Steps: []*gauge.Step{checkout}}}}
+
+	if !specUsesAnyStep(spec, map[string]bool{"enter user {}": true}) {
+		t.Errorf("Expected spec using a concept with the step to be affected")
+	}
+	if specUsesAnyStep(spec, map[string]bool{"type {}": true}) {
+		t.Errorf("Expected spec not using the step to be unaffected")
+	}
+}
@@ -0,0 +1,294 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"crypto/subtle"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/validation"
+)
+
+// Coordinator is the address at which the workers connect to execute the specs, when the execution is distributed.
+// It is on the loopback interface if no host is given. An address reachable from other hosts needs a token shared
+// with the workers in GAUGE_WORKER_TOKEN, as the workers get the specs and send back their results.
+var Coordinator string
+
+// haltPollInterval is the interval at which a coordinator waiting for workers checks if the execution is halted.
+const haltPollInterval = time.Second
+
+// coordinatedExecution hands out the specs lazily to remote workers, the same way a lazy parallel execution feeds its streams,
+// and merges the results sent back by the workers. The specs handed out to a worker which disconnects are handed out again.
+type coordinatedExecution struct {
+	address        string
+	token          string
+	manifest       *manifest.Manifest
+	specCollection *gauge.SpecCollection
+	pluginHandler  plugin.Handler
+	runner         runner.Runner
+	errMaps        *gauge.BuildErrors
+	suiteResult    *result.SuiteResult
+	startTime      time.Time
+	mutex          sync.Mutex
+	changed        *sync.Cond
+	// requeued holds the specs to be handed out again, as the workers executing them disconnected.
+	requeued [][]*gauge.Specification
+	// assigned is the number of groups of specs handed out, whose results are yet to be received.
+	assigned int
+	results  []*result.SpecResult
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+func newCoordinatedExecution(e *executionInfo) *coordinatedExecution {
+	ce := &coordinatedExecution{
+		address:        Coordinator,
+		token:          workerToken(),
+		manifest:       e.manifest,
+		specCollection: gauge.NewSpecCollection(e.specs.Specs(), true),
+		pluginHandler:  e.pluginHandler,
+		runner:         e.runner,
+		errMaps:        e.errMaps,
+		done:           make(chan struct{}),
+	}
+	ce.changed = sync.NewCond(&ce.mutex)
+	return ce
+}
+
+func (e *coordinatedExecution) run() *result.SuiteResult {
+	expectSpecs(e.specCollection.Specs())
+	e.start()
+	// the specs are executed by the runners of the workers.
+	if e.runner != nil {
+		if err := e.runner.Kill(); err != nil {
+			logger.Errorf(true, "Failed to kill Runner: %s", err.Error())
+		}
+	}
+	address, local, err := util.ListenAddress(e.address)
+	if err != nil {
+		logger.Fatalf(true, "Invalid coordinator address %s. %s", e.address, err.Error())
+	}
+	if !local && e.token == "" {
+		logger.Fatalf(true, "Set %s to a token shared with the workers, to listen for them at %s, which is reachable from other hosts.", workerTokenEnv, address)
+	}
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		logger.Fatalf(true, "Failed to listen for workers at %s. %s", e.address, err.Error())
+	}
+	defer func() {
+		_ = l.Close()
+	}()
+	if !e.specCollection.HasNext() {
+		// all the specs were executed by the run being resumed.
+		e.doneOnce.Do(func() { close(e.done) })
+	}
+	logger.Infof(true, "Waiting for workers to connect at %s.", l.Addr())
+	go e.acceptWorkers(l)
+	e.wait()
+	e.suiteResult = result.NewSuiteResult(ExecuteTags, e.startTime)
+	e.suiteResult.AddSpecResults(e.results)
+	e.suiteResult.UpdateExecTime(e.startTime)
+	e.suiteResult.SetSpecsSkippedCount()
+	e.finish()
+	return e.suiteResult
+}
+
+func (e *coordinatedExecution) start() {
+	e.startTime = time.Now()
+	event.Notify(event.NewExecutionEvent(event.SuiteStart, nil, nil, 0, &gauge_messages.ExecutionInfo{}))
+	e.pluginHandler = plugin.StartPlugins(e.manifest)
+}
+
+func (e *coordinatedExecution) finish() {
+	e.suiteResult = mergeDataTableSpecResults(withResumedResults(e.suiteResult))
+	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, e.suiteResult, 0, &gauge_messages.ExecutionInfo{}))
+	message := &gauge_messages.Message{
+		MessageType: gauge_messages.Message_SuiteExecutionResult,
+		SuiteExecutionResult: &gauge_messages.SuiteExecutionResult{
+			SuiteResult: gauge.ConvertToProtoSuiteResult(e.suiteResult),
+		},
+	}
+	e.pluginHandler.NotifyPlugins(message)
+	e.pluginHandler.GracefullyKillPlugins()
+}
+
+// wait waits for all the specs to be executed. If the execution is halted, for instance when interrupted,
+// the specs not handed out yet are skipped without waiting for workers to connect.
+func (e *coordinatedExecution) wait() {
+	ticker := time.NewTicker(haltPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.done:
+			return
+		case <-ticker.C:
+			if haltReason() != notHalted {
+				e.serve(nil)
+			}
+		}
+	}
+}
+
+func (e *coordinatedExecution) acceptWorkers(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		logger.Infof(true, "Worker %s connected.", conn.RemoteAddr())
+		go e.serve(newWorkerConn(conn))
+	}
+}
+
+// serve hands out specs to the worker until all the specs are executed. The specs which are not to be executed,
+// as the execution is halted or the specs they depend on did not pass, are skipped without being handed out.
+// The worker is nil when only such specs are to be served.
+func (e *coordinatedExecution) serve(w *workerConn) {
+	if w != nil {
+		defer w.close()
+		m, err := w.receive()
+		if err != nil {
+			logger.Errorf(true, "Worker %s disconnected. %s", w.conn.RemoteAddr(), err.Error())
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(m.Token), []byte(e.token)) != 1 {
+			logger.Errorf(true, "Rejected worker %s, as it did not send the token in %s.", w.conn.RemoteAddr(), workerTokenEnv)
+			return
+		}
+	}
+	for {
+		specs := e.next(w == nil)
+		if specs == nil {
+			break
+		}
+		if cause := haltReason(); cause != notHalted {
+			e.complete(specs, []*result.SpecResult{newSpecExecutor(specs[0], nil, nil, e.errMaps, 0).skipFor(cause)})
+			continue
+		}
+		if reason := prerequisiteFailure(specs[0]); reason != "" {
+			e.complete(specs, []*result.SpecResult{newSpecExecutor(specs[0], nil, nil, e.errMaps, 0).skip(reason)})
+			continue
+		}
+		if w == nil {
+			e.requeue(specs)
+			return
+		}
+		results, err := e.execute(w, specs)
+		if err != nil {
+			logger.Errorf(true, "Worker %s disconnected. %s", w.conn.RemoteAddr(), err.Error())
+			e.requeue(specs)
+			return
+		}
+		e.notify(specs[0], results)
+		e.complete(specs, results)
+	}
+	if w != nil {
+		_ = w.send(&workerMessage{Kind: doneMessage})
+		logger.Infof(true, "Worker %s is done.", w.conn.RemoteAddr())
+	}
+}
+
+// execute hands out the specs to the worker, and waits for their results.
+func (e *coordinatedExecution) execute(w *workerConn, specs []*gauge.Specification) ([]*result.SpecResult, error) {
+	settings := &workerSettings{MaxRetriesCount: MaxRetriesCount, RetryOnlyTags: RetryOnlyTags, TableRows: validation.TableRows}
+	if err := w.send(&workerMessage{Kind: specsMessage, Specs: specItems(specs), Settings: settings}); err != nil {
+		return nil, err
+	}
+	m, err := w.receive()
+	if err != nil {
+		return nil, err
+	}
+	var results []*result.SpecResult
+	for _, entry := range m.Results {
+		res, err := entry.specResult()
+		if err != nil {
+			return nil, err
+		}
+		// the worker reports the spec at its path on the worker's machine.
+		res.ProtoSpec.FileName = specs[0].FileName
+		failedScenariosCount.Add(int64(res.ScenarioFailedCount))
+		results = append(results, res)
+	}
+	logger.Infof(true, "Worker %s executed %s.", w.conn.RemoteAddr(), strings.Join(specItems(specs), ", "))
+	return results, nil
+}
+
+// next gives the next group of specs to be handed out. If none is available, as the remaining specs are locked, wait for
+// the specs they depend on or all the specs are handed out, it waits for the specs handed out to other workers to be
+// completed or requeued. Specs are not handed out before the specs they depend on complete, so that no worker is held
+// up waiting for them.
+// It is nil once all the specs are handed out and none is to be requeued, or if none is available without waiting.
+func (e *coordinatedExecution) next(nowait bool) []*gauge.Specification {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	for {
+		if n := len(e.requeued); n > 0 {
+			specs := e.requeued[n-1]
+			e.requeued = e.requeued[:n-1]
+			e.assigned++
+			return specs
+		}
+		if specs := e.specCollection.TryNext(readyToHandOut); specs != nil {
+			e.assigned++
+			return specs
+		}
+		if nowait || e.assigned == 0 {
+			return nil
+		}
+		e.changed.Wait()
+	}
+}
+
+// notify notifies the listeners of the spec results sent by a worker, the way they are notified of the specs executed locally.
+// The specs skipped by the coordinator are notified of when they are skipped.
+func (e *coordinatedExecution) notify(spec *gauge.Specification, results []*result.SpecResult) {
+	for _, res := range results {
+		executionInfo := newSpecExecutor(spec, nil, nil, e.errMaps, 0).currentExecutionInfo
+		executionInfo.CurrentSpec.IsFailed = res.GetFailed()
+		event.Notify(event.NewExecutionEvent(event.SpecStart, spec, res, 0, executionInfo))
+		event.Notify(event.NewExecutionEvent(event.SpecEnd, spec, res, 0, executionInfo))
+	}
+}
+
+// readyToHandOut tells if the specs can be handed out, as the specs they depend on are completed. Once the execution
+// is halted, all the specs are handed out to be skipped.
+func readyToHandOut(specs []*gauge.Specification) bool {
+	return haltReason() != notHalted || prerequisitesCompleted(specs)
+}
+
+func (e *coordinatedExecution) complete(specs []*gauge.Specification, results []*result.SpecResult) {
+	recordSpecOutcome(specs, results)
+	e.specCollection.Done(specs)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.assigned--
+	e.results = append(e.results, results...)
+	e.changed.Broadcast()
+	if e.assigned == 0 && len(e.requeued) == 0 && !e.specCollection.HasNext() {
+		e.doneOnce.Do(func() { close(e.done) })
+	}
+}
+
+func (e *coordinatedExecution) requeue(specs []*gauge.Specification) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.assigned--
+	e.requeued = append(e.requeued, specs)
+	e.changed.Broadcast()
+}
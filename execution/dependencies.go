@@ -0,0 +1,100 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/order"
+	"github.com/getgauge/gauge/util"
+)
+
+// specOutcomes tracks the outcome of the spec files in execution, so that the specs depending on them
+// wait for them to complete, and are skipped if they do not pass.
+type specOutcomes struct {
+	mutex         sync.Mutex
+	completed     *sync.Cond
+	prerequisites map[string][]string
+	// pending holds the number of specs of each file yet to be executed, as a file is split by data table rows and by scenarios.
+	pending   map[string]int
+	notPassed map[string]bool
+}
+
+var outcomes = newSpecOutcomes(nil)
+
+// newSpecOutcomes tracks the outcome of the given specs, none of which is yet expected to be executed.
+func newSpecOutcomes(specs []*gauge.Specification) *specOutcomes {
+	o := &specOutcomes{prerequisites: order.Prerequisites(specs), pending: make(map[string]int), notPassed: make(map[string]bool)}
+	o.completed = sync.NewCond(&o.mutex)
+	return o
+}
+
+// expect marks the specs to be executed next. The specs depending on them wait for them to complete.
+func (o *specOutcomes) expect(specs []*gauge.Specification) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	for _, spec := range specs {
+		o.pending[spec.FileName]++
+	}
+}
+
+// expectSpecs starts tracking the outcome of the specs to be executed. It is called before any of them is executed.
+func expectSpecs(specs []*gauge.Specification) {
+	outcomes = newSpecOutcomes(specs)
+	outcomes.expect(specs)
+}
+
+// recordSpecOutcome records the outcome of the specs of a group, which are the data table rows of the same spec file.
+func recordSpecOutcome(specs []*gauge.Specification, results []*result.SpecResult) {
+	o := outcomes
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	for _, spec := range specs {
+		o.pending[spec.FileName]--
+	}
+	for _, res := range results {
+		if res.GetFailed() || res.Skipped {
+			o.notPassed[specs[0].FileName] = true
+		}
+	}
+	o.completed.Broadcast()
+}
+
+// prerequisiteFailure waits for the specs which the spec depends on to complete, and gives the reason for skipping the spec
+// if any of them did not pass. It is empty if the spec can be executed.
+func prerequisiteFailure(spec *gauge.Specification) string {
+	o := outcomes
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	for _, p := range o.prerequisites[spec.FileName] {
+		for o.pending[p] > 0 {
+			o.completed.Wait()
+		}
+		if o.notPassed[p] {
+			return fmt.Sprintf("Skipped Reason: Prerequisite spec %s did not pass", util.RelPathToProjectRoot(p))
+		}
+	}
+	return ""
+}
+
+// prerequisitesCompleted tells if the specs which the specs depend on are completed, without waiting for them.
+func prerequisitesCompleted(specs []*gauge.Specification) bool {
+	o := outcomes
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	for _, spec := range specs {
+		for _, p := range o.prerequisites[spec.FileName] {
+			if o.pending[p] > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func specsWithDependency() (prerequisite, dependent *gauge.Specification) {
+	prerequisite = &gauge.Specification{FileName: "setup.spec"}
+	dependent = &gauge.Specification{FileName: "order.spec", Tags: &gauge.Tags{RawValues: [][]string{{gauge.DependsOnTagPrefix + "setup.spec"}}}}
+	return
+}
+
+func TestDependentSpecIsSkippedWhenPrerequisiteFails(t *testing.T) {
+	prerequisite, dependent := specsWithDependency()
+	expectSpecs([]*gauge.Specification{prerequisite, dependent})
+	defer expectSpecs(nil)
+
+	recordSpecOutcome([]*gauge.Specification{prerequisite}, []*result.SpecResult{{IsFailed: true}})
+
+	if got := prerequisiteFailure(dependent); got != "Skipped Reason: Prerequisite spec setup.spec did not pass" {
+		t.Errorf("Expected dependent spec to be skipped. Got %q", got)
+	}
+}
+
+func TestDependentSpecWaitsForPrerequisite(t *testing.T) {
+	prerequisite, dependent := specsWithDependency()
+	expectSpecs([]*gauge.Specification{prerequisite, dependent})
+	defer expectSpecs(nil)
+
+	reason := make(chan string)
+	go func() {
+		reason <- prerequisiteFailure(dependent)
+	}()
+	select {
+	case r := <-reason:
+		t.Fatalf("Expected dependent spec to wait for its prerequisite. Got %q", r)
+	case <-time.After(50 * time.Millisecond):
+	}
+	recordSpecOutcome([]*gauge.Specification{prerequisite}, []*result.SpecResult{{}})
+
+	if r := <-reason; r != "" {
+		t.Errorf("Expected dependent spec to be executed. Got %q", r)
+	}
+}
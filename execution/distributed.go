@@ -0,0 +1,140 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+// Kinds of the messages exchanged between the coordinator and its workers. A worker asks for specs with a next message,
+// and sends their results back with a results message, which also asks for more. The coordinator answers with a specs message,
+// or with a done message once all the specs are executed.
+const (
+	nextMessage    = "next"
+	specsMessage   = "specs"
+	resultsMessage = "results"
+	doneMessage    = "done"
+)
+
+// workerTokenEnv is the environment variable holding the token shared by the coordinator and its workers. A coordinator
+// with a token hands out the specs only to the workers which send the same token.
+const workerTokenEnv = "GAUGE_WORKER_TOKEN"
+
+// workerMessage is a message exchanged between the coordinator and a worker, sent as a line of JSON.
+type workerMessage struct {
+	Kind string `json:"kind"`
+	// Token is sent by the worker with its first message, to be let in by the coordinator.
+	Token string `json:"token,omitempty"`
+	// Specs holds the specs and scenarios to be executed, as paths relative to the project root.
+	Specs    []string           `json:"specs,omitempty"`
+	Settings *workerSettings    `json:"settings,omitempty"`
+	Results  []*checkpointEntry `json:"results,omitempty"`
+}
+
+// workerSettings holds the flags of the coordinator which change the way the workers execute the specs.
+type workerSettings struct {
+	MaxRetriesCount int    `json:"maxRetriesCount"`
+	RetryOnlyTags   string `json:"retryOnlyTags,omitempty"`
+	TableRows       string `json:"tableRows,omitempty"`
+}
+
+// workerConn sends and receives the messages exchanged between the coordinator and a worker.
+type workerConn struct {
+	conn    net.Conn
+	scanner *bufio.Scanner
+}
+
+func workerToken() string {
+	return os.Getenv(workerTokenEnv)
+}
+
+func newWorkerConn(conn net.Conn) *workerConn {
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(nil, 64*1024*1024)
+	return &workerConn{conn: conn, scanner: scanner}
+}
+
+func (c *workerConn) send(m *workerMessage) error {
+	line, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = c.conn.Write(append(line, '\n'))
+	return err
+}
+
+func (c *workerConn) receive() (*workerMessage, error) {
+	if !c.scanner.Scan() {
+		if err := c.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	m := &workerMessage{}
+	if err := json.Unmarshal(c.scanner.Bytes(), m); err != nil {
+		return nil, fmt.Errorf("invalid message from %s. %w", c.conn.RemoteAddr(), err)
+	}
+	return m, nil
+}
+
+func (c *workerConn) close() {
+	_ = c.conn.Close()
+}
+
+// specItems gives the scenarios of the specs as items relative to the project root, which can be executed by a worker
+// having the project elsewhere. A spec without scenarios is given as a whole.
+func specItems(specs []*gauge.Specification) (items []string) {
+	seen := make(map[string]bool)
+	for _, spec := range specs {
+		file := filepath.ToSlash(util.RelPathToProjectRoot(spec.FileName))
+		refs := []string{file}
+		if len(spec.Scenarios) > 0 {
+			refs = refs[:0]
+			for _, scn := range spec.Scenarios {
+				refs = append(refs, scenarioRef(file, scn))
+			}
+		}
+		for _, ref := range refs {
+			if !seen[ref] {
+				seen[ref] = true
+				items = append(items, ref)
+			}
+		}
+	}
+	return
+}
+
+// projectItems gives the paths of the items received from the coordinator within the project.
+func projectItems(items []string) (paths []string) {
+	for _, item := range items {
+		paths = append(paths, filepath.Join(config.ProjectRoot, filepath.FromSlash(item)))
+	}
+	return
+}
+
+func resultEntries(results []*result.SpecResult) ([]*checkpointEntry, error) {
+	var entries []*checkpointEntry
+	for _, res := range results {
+		entry, err := newCheckpointEntry(res)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
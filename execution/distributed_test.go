@@ -0,0 +1,192 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"net"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func testCoordinatedExecution(specs ...*gauge.Specification) *coordinatedExecution {
+	e := &coordinatedExecution{specCollection: gauge.NewSpecCollection(specs, true), errMaps: gauge.NewBuildErrors(), done: make(chan struct{})}
+	e.changed = sync.NewCond(&e.mutex)
+	expectSpecs(specs)
+	return e
+}
+
+// connectWorker connects a worker to the coordinator, and asks for specs.
+func connectWorker(t *testing.T, e *coordinatedExecution) *workerConn {
+	client, server := net.Pipe()
+	go e.serve(newWorkerConn(server))
+	w := newWorkerConn(client)
+	if err := w.send(&workerMessage{Kind: nextMessage}); err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestSpecItemsAreRelativeToProjectRoot(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	a := specWithScenarioLines(filepath.Join(config.ProjectRoot, "specs", "a.spec"), 3, 7)
+	b := specWithScenarioLines(filepath.Join(config.ProjectRoot, "b.spec"))
+
+	got := specItems([]*gauge.Specification{a, a, b})
+
+	want := []string{"specs/a.spec:3", "specs/a.spec:7", "b.spec"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v\n\tGot: %v", want, got)
+	}
+	if paths := projectItems(want[2:]); paths[0] != b.FileName {
+		t.Errorf("Expected item to be the spec in project. Got %v", paths)
+	}
+}
+
+func TestCoordinatorHandsOutSpecsOfDisconnectedWorkerAgain(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	resetFailedScenariosCount()
+	a := specWithScenarioLines(filepath.Join(config.ProjectRoot, "a.spec"), 3)
+	b := specWithScenarioLines(filepath.Join(config.ProjectRoot, "b.spec"), 4)
+	e := testCoordinatedExecution(a, b)
+
+	w1 := connectWorker(t, e)
+	m, err := w1.receive()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Kind != specsMessage || !reflect.DeepEqual(m.Specs, []string{"a.spec:3"}) {
+		t.Fatalf("Expected a.spec to be handed out. Got %v", m)
+	}
+	w1.close()
+
+	w2 := connectWorker(t, e)
+	var executed []string
+	for {
+		m, err := w2.receive()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Kind == doneMessage {
+			break
+		}
+		executed = append(executed, m.Specs...)
+		file := strings.Split(m.Specs[0], ":")[0]
+		entries, err := resultEntries([]*result.SpecResult{completedSpecResult("/worker/"+file, file == "b.spec", 3)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w2.send(&workerMessage{Kind: resultsMessage, Results: entries}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w2.close()
+
+	select {
+	case <-e.done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected execution to be done")
+	}
+	sort.Strings(executed)
+	if !reflect.DeepEqual(executed, []string{"a.spec:3", "b.spec:4"}) {
+		t.Errorf("Expected all specs to be executed by the second worker. Got %v", executed)
+	}
+	var files []string
+	for _, res := range e.results {
+		files = append(files, res.ProtoSpec.GetFileName())
+	}
+	sort.Strings(files)
+	if !reflect.DeepEqual(files, []string{a.FileName, b.FileName}) {
+		t.Errorf("Expected results of the specs in the project. Got %v", files)
+	}
+}
+
+func TestCoordinatorRejectsWorkerWithoutItsToken(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	resetFailedScenariosCount()
+	a := specWithScenarioLines(filepath.Join(config.ProjectRoot, "a.spec"), 3)
+	e := testCoordinatedExecution(a)
+	defer expectSpecs(nil)
+	e.token = "secret"
+
+	w := connectWorker(t, e)
+	defer w.close()
+	if m, err := w.receive(); err == nil {
+		t.Fatalf("Expected worker without the token to be disconnected. Got %v", m)
+	}
+	if !e.specCollection.HasNext() {
+		t.Error("Expected the specs not to be handed out to a worker without the token")
+	}
+}
+
+func TestCoordinatorNotifiesSpecEventsOfResultsSentByWorker(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	resetFailedScenariosCount()
+	a := specWithScenarioLines(filepath.Join(config.ProjectRoot, "a.spec"), 3)
+	e := testCoordinatedExecution(a)
+	event.InitRegistry()
+	defer event.InitRegistry()
+	ch := make(chan event.ExecutionEvent, 4)
+	event.Register(ch, event.SpecStart, event.SpecEnd)
+
+	w := connectWorker(t, e)
+	if _, err := w.receive(); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := resultEntries([]*result.SpecResult{completedSpecResult("/worker/a.spec", true, 3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.send(&workerMessage{Kind: resultsMessage, Results: entries}); err != nil {
+		t.Fatal(err)
+	}
+	if m, err := w.receive(); err != nil || m.Kind != doneMessage {
+		t.Fatalf("Expected worker to be done. Got %v, %v", m, err)
+	}
+	w.close()
+
+	if len(ch) != 2 {
+		t.Fatalf("Expected spec start and end events for the result sent by the worker, got %d events", len(ch))
+	}
+	for _, topic := range []event.Topic{event.SpecStart, event.SpecEnd} {
+		ev := <-ch
+		if ev.Topic != topic || ev.Item != a || ev.Result.(*result.SpecResult).ProtoSpec.GetFileName() != a.FileName {
+			t.Errorf("Expected %v event for %s, got %v for %v", topic, a.FileName, ev.Topic, ev.Item)
+		}
+		if !ev.ExecutionInfo.GetCurrentSpec().GetIsFailed() {
+			t.Errorf("Expected the spec to be reported as failed in %v event", topic)
+		}
+	}
+}
+
+func TestCoordinatorDoesNotHandOutSpecBeforeItsPrerequisiteCompletes(t *testing.T) {
+	resetFailedScenariosCount()
+	prerequisite, dependent := specsWithDependency()
+	e := testCoordinatedExecution(dependent, prerequisite)
+	defer expectSpecs(nil)
+
+	if specs := e.next(true); !reflect.DeepEqual(specs, []*gauge.Specification{prerequisite}) {
+		t.Fatalf("Expected the prerequisite to be handed out first. Got %v", specs)
+	}
+	if specs := e.next(true); specs != nil {
+		t.Fatalf("Expected the dependent spec not to be handed out while its prerequisite is executed. Got %v", specs)
+	}
+	e.complete([]*gauge.Specification{prerequisite}, []*result.SpecResult{{ProtoSpec: &gauge_messages.ProtoSpec{}}})
+	if specs := e.next(true); !reflect.DeepEqual(specs, []*gauge.Specification{dependent}) {
+		t.Errorf("Expected the dependent spec to be handed out once its prerequisite completes. Got %v", specs)
+	}
+}
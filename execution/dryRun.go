@@ -0,0 +1,331 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/timing"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/validation"
+)
+
+// DryRun if true prints the execution plan, i.e. the specs, scenarios and resolved steps to be executed by each stream, without executing them.
+var DryRun bool
+
+const serial = "serial"
+
+type executionPlan struct {
+	Strategy string        `json:"strategy"`
+	Streams  []*streamPlan `json:"streams"`
+}
+
+// streamPlan holds the specs to be executed by a stream. Stream 0 means that the specs are assigned to streams during execution.
+type streamPlan struct {
+	Stream int         `json:"stream"`
+	Specs  []*specPlan `json:"specs"`
+}
+
+type specPlan struct {
+	FileName    string          `json:"fileName"`
+	Heading     string          `json:"heading"`
+	Tags        []string        `json:"tags,omitempty"`
+	Row         int             `json:"row,omitempty"`
+	SkipReasons []string        `json:"skipReasons,omitempty"`
+	Contexts    []*stepPlan     `json:"contexts,omitempty"`
+	Scenarios   []*scenarioPlan `json:"scenarios"`
+	TearDowns   []*stepPlan     `json:"tearDowns,omitempty"`
+}
+
+type scenarioPlan struct {
+	Heading     string      `json:"heading"`
+	Line        int         `json:"line"`
+	Tags        []string    `json:"tags,omitempty"`
+	Row         int         `json:"row,omitempty"`
+	SkipReasons []string    `json:"skipReasons,omitempty"`
+	Steps       []*stepPlan `json:"steps"`
+}
+
+type stepPlan struct {
+	Text       string      `json:"text"`
+	Concept    bool        `json:"concept,omitempty"`
+	SkipReason string      `json:"skipReason,omitempty"`
+	Steps      []*stepPlan `json:"steps,omitempty"`
+}
+
+func printExecutionPlan(res *validation.ValidationResult) int {
+	defer func() {
+		if err := res.Runner.Kill(); err != nil {
+			logger.Errorf(true, "unable to kill runner: %s", err.Error())
+		}
+	}()
+	plan, err := newExecutionPlan(res.SpecCollection.Specs(), res.ErrMap)
+	if err != nil {
+		logger.Errorf(true, "Failed to resolve execution plan. %s", err.Error())
+		return ExecutionFailed
+	}
+	if MachineReadable {
+		b, err := json.MarshalIndent(plan, "", "\t")
+		if err != nil {
+			logger.Errorf(true, "Failed to convert execution plan to JSON. %s", err.Error())
+			return ExecutionFailed
+		}
+		fmt.Println(string(b))
+	} else {
+		logger.Info(true, plan.String())
+	}
+	if !res.ParseOk {
+		return ParseFailed
+	}
+	return Success
+}
+
+func newExecutionPlan(specs []*gauge.Specification, errMap *gauge.BuildErrors) (*executionPlan, error) {
+	plan := &executionPlan{}
+	var streams [][]*gauge.Specification
+	switch {
+	case !InParallel:
+		plan.Strategy = serial
+		streams = append(streams, gauge.NewSpecCollection(specs, true).Specs())
+	case isLazy():
+		plan.Strategy = Lazy
+		if isScenarioGranularity() {
+			specs = splitSpecsByScenarios(specs, NumberOfExecutionStreams, errMap)
+		}
+		streams = append(streams, specs)
+	default:
+		plan.Strategy = Eager
+		if isScenarioGranularity() {
+			specs = splitSpecsByScenarios(specs, NumberOfExecutionStreams, errMap)
+		}
+		n := NumberOfExecutionStreams
+		if n > len(specs) {
+			n = len(specs)
+		}
+		for _, c := range filter.DistributeSpecs(specs, n, timing.SpecDurations()) {
+			if c == nil {
+				streams = append(streams, nil)
+				continue
+			}
+			streams = append(streams, c.Specs())
+		}
+	}
+	for i, s := range streams {
+		stream := &streamPlan{Stream: i + 1, Specs: []*specPlan{}}
+		if plan.Strategy == Lazy {
+			stream.Stream = 0
+		}
+		for _, spec := range s {
+			p, err := newSpecPlan(spec, errMap)
+			if err != nil {
+				return nil, err
+			}
+			stream.Specs = append(stream.Specs, p)
+		}
+		plan.Streams = append(plan.Streams, stream)
+	}
+	return plan, nil
+}
+
+func newSpecPlan(spec *gauge.Specification, errMap *gauge.BuildErrors) (*specPlan, error) {
+	p := &specPlan{FileName: spec.FileName, Heading: spec.Heading.Value, Tags: getTagValue(spec.Tags), Scenarios: []*scenarioPlan{}}
+	for _, err := range errMap.SpecErrs[spec] {
+		p.SkipReasons = append(p.SkipReasons, err.Error())
+	}
+	parser.GetResolvedDataTablerows(spec.DataTable.Table)
+	if spec.DataTable.Table.GetRowCount() > 0 && len(spec.Scenarios) > 0 {
+		p.Row = spec.Scenarios[0].SpecDataTableRowIndex + 1
+	}
+	lookup := new(gauge.ArgLookup)
+	if err := lookup.ReadDataTableRow(spec.DataTable.Table, 0); err != nil {
+		return nil, err
+	}
+	skipFn := planSkipInfo(errMap)
+	var err error
+	if p.Contexts, err = stepPlans(stepItems(spec.Contexts), lookup, skipFn); err != nil {
+		return nil, err
+	}
+	if p.TearDowns, err = stepPlans(stepItems(spec.TearDownSteps), lookup, skipFn); err != nil {
+		return nil, err
+	}
+	for _, scenario := range spec.Scenarios {
+		s, err := newScenarioPlan(scenario, lookup, errMap)
+		if err != nil {
+			return nil, err
+		}
+		p.Scenarios = append(p.Scenarios, s)
+	}
+	return p, nil
+}
+
+func newScenarioPlan(scenario *gauge.Scenario, specLookup *gauge.ArgLookup, errMap *gauge.BuildErrors) (*scenarioPlan, error) {
+	p := &scenarioPlan{Heading: scenario.Heading.Value, Line: scenario.Span.Start, Tags: getTagValue(scenario.Tags)}
+	if !shouldExecuteForRows(scenario) {
+		p.SkipReasons = append(p.SkipReasons, "Doesn't satisfy --table-rows flag condition")
+	}
+	for _, err := range errMap.ScenarioErrs[scenario] {
+		p.SkipReasons = append(p.SkipReasons, err.Error())
+	}
+	lookup, err := specLookup.GetCopy()
+	if err != nil {
+		return nil, err
+	}
+	if scenario.ScenarioDataTableRow.IsInitialized() {
+		p.Row = scenario.ScenarioDataTableRowIndex + 1
+		parser.GetResolvedDataTablerows(&scenario.ScenarioDataTableRow)
+		if err := lookup.ReadDataTableRow(&scenario.ScenarioDataTableRow, 0); err != nil {
+			return nil, err
+		}
+	}
+	steps, err := stepPlans(scenario.Items, lookup, planSkipInfo(errMap))
+	if err != nil {
+		return nil, err
+	}
+	p.Steps = steps
+	return p, nil
+}
+
+func planSkipInfo(errMap *gauge.BuildErrors) setSkipInfoFn {
+	return func(protoStep *gauge_messages.ProtoStep, step *gauge.Step) {
+		protoStep.StepExecutionResult = &gauge_messages.ProtoStepExecutionResult{}
+		if _, ok := errMap.StepErrs[step]; ok {
+			protoStep.StepExecutionResult.Skipped = true
+			protoStep.StepExecutionResult.SkippedReason = "Step implementation not found"
+		}
+	}
+}
+
+func stepItems(steps []*gauge.Step) []gauge.Item {
+	items := make([]gauge.Item, len(steps))
+	for i, step := range steps {
+		items[i] = step
+	}
+	return items
+}
+
+func stepPlans(items []gauge.Item, lookup *gauge.ArgLookup, skipFn setSkipInfoFn) ([]*stepPlan, error) {
+	protoItems, err := resolveItems(items, lookup, skipFn)
+	if err != nil {
+		return nil, err
+	}
+	return toStepPlans(protoItems), nil
+}
+
+func toStepPlans(items []*gauge_messages.ProtoItem) (plans []*stepPlan) {
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Step:
+			plans = append(plans, &stepPlan{
+				Text:       resolvedStepText(item.GetStep()),
+				SkipReason: item.GetStep().GetStepExecutionResult().GetSkippedReason(),
+			})
+		case gauge_messages.ProtoItem_Concept:
+			plans = append(plans, &stepPlan{
+				Text:    resolvedStepText(item.GetConcept().GetConceptStep()),
+				Concept: true,
+				Steps:   toStepPlans(item.GetConcept().GetSteps()),
+			})
+		}
+	}
+	return
+}
+
+// resolvedStepText returns the step text with the parameters replaced by their resolved values, as sent to the runner.
+func resolvedStepText(step *gauge_messages.ProtoStep) string {
+	var text strings.Builder
+	var tables []*gauge_messages.ProtoTable
+	for _, fragment := range step.GetFragments() {
+		if fragment.GetFragmentType() == gauge_messages.Fragment_Text {
+			text.WriteString(fragment.GetText())
+			continue
+		}
+		p := fragment.GetParameter()
+		switch p.GetParameterType() {
+		case gauge_messages.Parameter_Table, gauge_messages.Parameter_Special_Table:
+			text.WriteString("<table>")
+			tables = append(tables, p.GetTable())
+		default:
+			text.WriteString(fmt.Sprintf("\"%s\"", p.GetValue()))
+		}
+	}
+	for _, t := range tables {
+		text.WriteString("\n" + tableText(t))
+	}
+	return text.String()
+}
+
+func tableText(t *gauge_messages.ProtoTable) string {
+	rows := []string{"|" + strings.Join(t.GetHeaders().GetCells(), "|") + "|"}
+	for _, r := range t.GetRows() {
+		rows = append(rows, "|"+strings.Join(r.GetCells(), "|")+"|")
+	}
+	return strings.Join(rows, "\n")
+}
+
+func (p *executionPlan) String() string {
+	var b strings.Builder
+	for _, s := range p.Streams {
+		switch {
+		case s.Stream == 0:
+			b.WriteString(fmt.Sprintf("Specs to be assigned to %d streams during execution, in order:\n", NumberOfExecutionStreams))
+		case p.Strategy == serial:
+			b.WriteString("Specs to be executed, in order:\n")
+		default:
+			b.WriteString(fmt.Sprintf("Stream %d:\n", s.Stream))
+		}
+		for _, spec := range s.Specs {
+			spec.write(&b)
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func (p *specPlan) write(b *strings.Builder) {
+	heading := fmt.Sprintf("# %s (%s)", p.Heading, p.FileName)
+	if p.Row > 0 {
+		heading += fmt.Sprintf(" [row %d]", p.Row)
+	}
+	writeLine(b, 1, heading)
+	for _, r := range p.SkipReasons {
+		writeLine(b, 2, "Skipped: "+r)
+	}
+	for _, s := range p.Scenarios {
+		heading := fmt.Sprintf("## %s (line %d)", s.Heading, s.Line)
+		if s.Row > 0 {
+			heading += fmt.Sprintf(" [row %d]", s.Row)
+		}
+		writeLine(b, 2, heading)
+		for _, r := range s.SkipReasons {
+			writeLine(b, 3, "Skipped: "+r)
+		}
+		writeSteps(b, 3, p.Contexts)
+		writeSteps(b, 3, s.Steps)
+		writeSteps(b, 3, p.TearDowns)
+	}
+}
+
+func writeSteps(b *strings.Builder, indent int, steps []*stepPlan) {
+	for _, s := range steps {
+		text := "* " + s.Text
+		if s.SkipReason != "" {
+			text += " (skipped: " + s.SkipReason + ")"
+		}
+		writeLine(b, indent, text)
+		writeSteps(b, indent+1, s.Steps)
+	}
+}
+
+func writeLine(b *strings.Builder, indent int, text string) {
+	prefix := strings.Repeat("  ", indent)
+	b.WriteString(prefix + strings.ReplaceAll(text, "\n", "\n"+prefix+"  ") + "\n")
+}
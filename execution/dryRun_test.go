@@ -0,0 +1,152 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+func parsePlanSpec(t *testing.T, fileName string, specText string) []*gauge.Specification {
+	spec, res, err := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary(), fileName)
+	if err != nil || !res.Ok {
+		t.Fatalf("unable to parse spec %s: %v", fileName, res.ParseErrors)
+	}
+	return parser.GetSpecsForDataTableRows([]*gauge.Specification{spec}, gauge.NewBuildErrors())
+}
+
+func TestExecutionPlanResolvesDataTableParams(t *testing.T) {
+	InParallel = false
+	specText := newSpecBuilder().specHeading("A spec heading").
+		tableHeader("id", "name").
+		tableRow("123", "foo").
+		tableRow("456", "bar").
+		scenarioHeading("First scenario").
+		step("create user <id> with name <name>").
+		String()
+	specs := parsePlanSpec(t, "user.spec", specText)
+
+	plan, err := newExecutionPlan(specs, gauge.NewBuildErrors())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Strategy != serial || len(plan.Streams) != 1 {
+		t.Fatalf("Want a single serial stream, Got: %s with %d streams", plan.Strategy, len(plan.Streams))
+	}
+	var got []string
+	for _, spec := range plan.Streams[0].Specs {
+		for _, scn := range spec.Scenarios {
+			for _, step := range scn.Steps {
+				got = append(got, step.Text)
+			}
+		}
+	}
+	want := []string{"create user \"123\" with name \"foo\"", "create user \"456\" with name \"bar\""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
+
+func TestExecutionPlanSkipsRowsNotInTableRows(t *testing.T) {
+	InParallel = false
+	SetTableRows("2")
+	defer SetTableRows("")
+	specText := newSpecBuilder().specHeading("A spec heading").
+		tableHeader("id").
+		tableRow("123").
+		tableRow("456").
+		scenarioHeading("First scenario").
+		step("create user <id>").
+		String()
+	specs := parsePlanSpec(t, "user.spec", specText)
+
+	plan, err := newExecutionPlan(specs, gauge.NewBuildErrors())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var skipped []bool
+	for _, spec := range plan.Streams[0].Specs {
+		for _, scn := range spec.Scenarios {
+			skipped = append(skipped, len(scn.SkipReasons) > 0)
+		}
+	}
+	want := []bool{true, false}
+	if !reflect.DeepEqual(skipped, want) {
+		t.Errorf("Want: %v, Got: %v", want, skipped)
+	}
+}
+
+func TestExecutionPlanSkipsRowsNotMatchingTableRowsFilter(t *testing.T) {
+	InParallel = false
+	SetTableRows("country=IN && tier!=gold")
+	defer SetTableRows("")
+	specText := newSpecBuilder().specHeading("A spec heading").
+		tableHeader("country", "tier").
+		tableRow("IN", "gold").
+		tableRow("IN", "silver").
+		tableRow("US", "silver").
+		scenarioHeading("First scenario").
+		step("create user in <country> with <tier>").
+		String()
+	specs := parsePlanSpec(t, "user.spec", specText)
+
+	plan, err := newExecutionPlan(specs, gauge.NewBuildErrors())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var skipped []bool
+	for _, spec := range plan.Streams[0].Specs {
+		for _, scn := range spec.Scenarios {
+			skipped = append(skipped, len(scn.SkipReasons) > 0)
+		}
+	}
+	want := []bool{true, false, true}
+	if !reflect.DeepEqual(skipped, want) {
+		t.Errorf("Want: %v, Got: %v", want, skipped)
+	}
+}
+
+func TestExecutionPlanDistributesSpecsAcrossStreams(t *testing.T) {
+	InParallel = true
+	Strategy = Eager
+	NumberOfExecutionStreams = 2
+	defer func() {
+		InParallel = false
+		Strategy = ""
+		NumberOfExecutionStreams = 0
+	}()
+	var specs []*gauge.Specification
+	for _, f := range []string{"one.spec", "two.spec", "three.spec"} {
+		specText := newSpecBuilder().specHeading(f).scenarioHeading("Scenario").step("a step").String()
+		specs = append(specs, parsePlanSpec(t, f, specText)...)
+	}
+
+	plan, err := newExecutionPlan(specs, gauge.NewBuildErrors())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Strategy != Eager || len(plan.Streams) != 2 {
+		t.Fatalf("Want 2 eager streams, Got: %s with %d streams", plan.Strategy, len(plan.Streams))
+	}
+	count := 0
+	for i, s := range plan.Streams {
+		if s.Stream != i+1 {
+			t.Errorf("Want stream number %d, Got: %d", i+1, s.Stream)
+		}
+		count += len(s.Specs)
+	}
+	if count != len(specs) {
+		t.Errorf("Want %d specs in plan, Got: %d", len(specs), count)
+	}
+}
@@ -51,6 +51,11 @@ const (
 
 var subscriberRegistry map[Topic][]chan ExecutionEvent
 
+// Handler handles an event on the goroutine notifying it, while the result of the event is yet to be updated any further.
+type Handler func(ExecutionEvent)
+
+var handlerRegistry map[Topic][]Handler
+
 // InitRegistry is used for console reporting, execution API and rerun of specs
 func InitRegistry() {
 	subscriberRegistry = make(map[Topic][]chan ExecutionEvent)
@@ -63,6 +68,7 @@ func InitRegistry() {
 	subscriberRegistry[ScenarioEnd] = make([]chan ExecutionEvent, 0)
 	subscriberRegistry[SpecEnd] = make([]chan ExecutionEvent, 0)
 	subscriberRegistry[SuiteEnd] = make([]chan ExecutionEvent, 0)
+	handlerRegistry = make(map[Topic][]Handler)
 }
 
 // Register registers the given channel to the given list of topics. Any updates for the given topics
@@ -73,8 +79,19 @@ func Register(ch chan ExecutionEvent, topics ...Topic) {
 	}
 }
 
+// RegisterHandler registers the given handler to the given list of topics. The handler is called with the events of
+// the topics before the channels registered to them are sent the events.
+func RegisterHandler(h Handler, topics ...Topic) {
+	for _, t := range topics {
+		handlerRegistry[t] = append(handlerRegistry[t], h)
+	}
+}
+
 // Notify notifies all the subscribers of the event about its occurrence
 func Notify(e ExecutionEvent) {
+	for _, h := range handlerRegistry[e.Topic] {
+		h(e)
+	}
 	for _, c := range subscriberRegistry[e.Topic] {
 		c <- e
 	}
@@ -103,6 +103,24 @@ func (s *MySuite) TestNotify(c *C) {
 	c.Assert(<-ch2, DeepEquals, stepEndEvent)
 }
 
+func (s *MySuite) TestNotifyCallsHandlersBeforeSendingToChannels(c *C) {
+	InitRegistry()
+	defer InitRegistry()
+	ch := make(chan ExecutionEvent, 1)
+	Register(ch, ScenarioEnd)
+	var handled []ExecutionEvent
+	RegisterHandler(func(e ExecutionEvent) {
+		c.Assert(len(ch), Equals, 0)
+		handled = append(handled, e)
+	}, ScenarioEnd)
+	scenarioEndEvent := NewExecutionEvent(ScenarioEnd, nil, nil, 0, &gauge_messages.ExecutionInfo{})
+
+	Notify(scenarioEndEvent)
+
+	c.Assert(handled, DeepEquals, []ExecutionEvent{scenarioEndEvent})
+	c.Assert(<-ch, DeepEquals, scenarioEndEvent)
+}
+
 func contains(arr []chan ExecutionEvent, key chan ExecutionEvent) bool {
 	for _, k := range arr {
 		if k == key {
@@ -44,10 +44,15 @@ import (
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/history"
+	"github.com/getgauge/gauge/execution/quarantine"
 	"github.com/getgauge/gauge/execution/rerun"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/execution/stream"
+	"github.com/getgauge/gauge/execution/timing"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/order"
 	"github.com/getgauge/gauge/plugin/install"
 	"github.com/getgauge/gauge/reporter"
 	"github.com/getgauge/gauge/validation"
@@ -79,6 +84,12 @@ var MachineReadable bool
 
 var ExecutionArgs []*gauge.ExecutionArg
 
+// EventsAddress is the local address the execution events are streamed on, like 127.0.0.1:9000. Events are not streamed if it is empty.
+var EventsAddress string
+
+// EventsAllowRemote lets the execution events be streamed on an address reachable from other hosts.
+var EventsAllowRemote bool
+
 type suiteExecutor interface {
 	run() *result.SuiteResult
 }
@@ -94,16 +105,13 @@ var ExecuteSpecs = func(specDirs []string) int {
 	if err != nil {
 		logger.Fatal(true, err.Error())
 	}
+	startTimeBudget()
 	if config.CheckUpdates() {
 		i := &install.UpdateFacade{}
 		i.BufferUpdateDetails()
 		defer i.PrintUpdateBuffer()
 	}
-	skel.SetupPlugins(MachineReadable)
-	err = os.Setenv(gaugeParallelStreamCountEnv, strconv.Itoa(NumberOfExecutionStreams))
-	if err != nil {
-		logger.Fatalf(true, "failed to set env %s. %s", gaugeParallelStreamCountEnv, err.Error())
-	}
+	setupExecution()
 
 	res := validation.ValidateSpecs(specDirs, false)
 	if len(res.Errs) > 0 {
@@ -112,6 +120,20 @@ var ExecuteSpecs = func(specDirs []string) int {
 		}
 		return ValidationFailed
 	}
+	if ChangedSince != "" {
+		affected, err := specsChangedSince(ChangedSince, res.SpecCollection, res.Runner)
+		if err != nil {
+			logger.Fatalf(true, "Failed to find the specs affected by the changes since %s. %s", ChangedSince, err.Error())
+		}
+		if affected.Size() < 1 {
+			logger.Infof(true, "No specifications affected by the changes since %s.", ChangedSince)
+			if err := res.Runner.Kill(); err != nil {
+				logger.Errorf(false, "unable to kill runner: %s", err.Error())
+			}
+			return Success
+		}
+		res.SpecCollection = affected
+	}
 	if res.SpecCollection.Size() < 1 {
 		logger.Infof(true, "No specifications found in %s.", strings.Join(specDirs, ", "))
 		err := res.Runner.Kill()
@@ -123,14 +145,59 @@ var ExecuteSpecs = func(specDirs []string) int {
 		}
 		return ExecutionFailed
 	}
+	if DryRun {
+		return printExecutionPlan(res)
+	}
+	if RepeatCount > 1 {
+		return executeRepeatedly(res, specDirs)
+	}
+	return executeValidatedSpecs(res, specDirs)
+}
+
+func setupExecution() {
+	skel.SetupPlugins(MachineReadable)
+	err := os.Setenv(gaugeParallelStreamCountEnv, strconv.Itoa(NumberOfExecutionStreams))
+	if err != nil {
+		logger.Fatalf(true, "failed to set env %s. %s", gaugeParallelStreamCountEnv, err.Error())
+	}
+	if err := quarantine.Load(); err != nil {
+		logger.Fatal(true, err.Error())
+	}
+	if EventsAddress != "" {
+		if err := stream.Start(EventsAddress, EventsAllowRemote); err != nil {
+			logger.Fatalf(true, "Failed to stream the execution events on %s. %s", EventsAddress, err.Error())
+		}
+	}
+}
+
+// executeValidatedSpecs registers the execution listeners, executes the validated specs and prints the result.
+// The listeners stop once the suite ends, as the specs are executed more than once in a process in watch and repeat mode.
+func executeValidatedSpecs(res *validation.ValidationResult, specDirs []string) int {
+	resetFailedScenariosCount()
+	resetRunnerRestartsCount()
 	event.InitRegistry()
 	wg := &sync.WaitGroup{}
 	reporter.ListenExecutionEvents(wg)
 	rerun.ListenFailedScenarios(wg, specDirs)
+	timing.ListenSuiteEndAndSaveTimings(wg)
+	history.ListenSuiteEndAndSaveHistory(wg)
+	ListenSpecEndAndCheckpoint(wg)
 	if env.SaveExecutionResult() {
 		ListenSuiteEndAndSaveResult(wg)
 	}
+	if repeatStats != nil {
+		listenSuiteEndAndCollectRepeatStats(wg)
+	}
+	if EventsAddress != "" {
+		stream.ListenExecutionEvents(wg)
+	}
 	defer wg.Wait()
+	resumedResults = nil
+	if Resume {
+		res.SpecCollection = resumeExecution(res.SpecCollection, res.ErrMap)
+	}
+	stopHandlingInterrupts := handleInterrupts()
+	defer stopHandlingInterrupts()
 	ei := newExecutionInfo(res.SpecCollection, res.Runner, nil, res.ErrMap, InParallel, 0)
 
 	e := ei.getExecutor()
@@ -178,13 +245,15 @@ func printExecutionResult(suiteResult *result.SuiteResult, isParsingOk bool) int
 	nFailedScenarios := 0
 	nPassedScenarios := 0
 	nSkippedScenarios := 0
+	nQuarantinedScenarios := 0
 	for _, specResult := range suiteResult.SpecResults {
 		nExecutedScenarios += specResult.ScenarioCount
 		nFailedScenarios += specResult.ScenarioFailedCount
 		nSkippedScenarios += specResult.ScenarioSkippedCount
+		nQuarantinedScenarios += specResult.ScenarioQuarantinedCount
 	}
 	nExecutedScenarios -= nSkippedScenarios
-	nPassedScenarios = nExecutedScenarios - nFailedScenarios
+	nPassedScenarios = nExecutedScenarios - nFailedScenarios - nQuarantinedScenarios
 	if nExecutedScenarios < 0 {
 		nExecutedScenarios = 0
 	}
@@ -193,25 +262,56 @@ func printExecutionResult(suiteResult *result.SuiteResult, isParsingOk bool) int
 		nPassedScenarios = 0
 	}
 
-	s := statusJSON(nExecutedSpecs, nPassedSpecs, nFailedSpecs, nSkippedSpecs, nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios)
+	s := statusJSON(nExecutedSpecs, nPassedSpecs, nFailedSpecs, nSkippedSpecs, nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios, nQuarantinedScenarios)
 	logger.Infof(true, "Specifications:\t%d executed\t%d passed\t%d failed\t%d skipped", nExecutedSpecs, nPassedSpecs, nFailedSpecs, nSkippedSpecs)
-	logger.Infof(true, "Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped", nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios)
+	if nQuarantinedScenarios > 0 {
+		logger.Infof(true, "Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped\t%d quarantined", nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios, nQuarantinedScenarios)
+	} else {
+		logger.Infof(true, "Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped", nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios)
+	}
 	logger.Infof(true, "\nTotal time taken: %s", time.Millisecond*time.Duration(suiteResult.ExecutionTime))
 	writeExecutionResult(s)
 
 	if !isParsingOk {
 		return ParseFailed
 	}
+	if isInterrupted() {
+		return Interrupted
+	}
+	if suiteResult.IsFailed && skippedForMaxFailures.Load() {
+		return MaxFailuresReached
+	}
 	if suiteResult.IsFailed {
 		return ExecutionFailed
 	}
 	return Success
 }
 
+// RankSpecs ranks the specs for the failed-first or duration-desc sort order, from the failures of the last run
+// or from the timings recorded in the previous runs.
+func RankSpecs(sortOrder string) []string {
+	return order.Rank(sortOrder, rerun.FailedSpecs(), timing.SpecDurations())
+}
+
 func validateFlags() error {
 	if MaxRetriesCount < 1 {
 		return fmt.Errorf("invalid input(%s) to --max-retries-count flag", strconv.Itoa(MaxRetriesCount))
 	}
+	if MaxFailures < 0 {
+		return fmt.Errorf("invalid input(%s) to --max-failures flag", strconv.Itoa(MaxFailures))
+	}
+	if TimeBudget < 0 {
+		return fmt.Errorf("invalid input(%s) to --time-budget flag", TimeBudget)
+	}
+	if MaxRunnerRestarts < 0 {
+		return fmt.Errorf("invalid input(%s) to --max-runner-restarts flag", strconv.Itoa(MaxRunnerRestarts))
+	}
+	if RepeatCount < 1 {
+		return fmt.Errorf("invalid input(%s) to --repeat-count flag", strconv.Itoa(RepeatCount))
+	}
+	if !order.IsValid(order.SortOrder) {
+		return fmt.Errorf("invalid input(%s) to --sort flag", order.SortOrder)
+	}
 	if !InParallel {
 		return nil
 	}
@@ -221,5 +321,8 @@ func validateFlags() error {
 	if !isValidStrategy(Strategy) {
 		return fmt.Errorf("invalid input(%s) to --strategy flag", Strategy)
 	}
+	if !isValidGranularity(ParallelGranularity) {
+		return fmt.Errorf("invalid input(%s) to --parallel-granularity flag", ParallelGranularity)
+	}
 	return nil
 }
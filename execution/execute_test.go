@@ -7,9 +7,16 @@
 package execution
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/order"
 
 	. "gopkg.in/check.v1"
 )
@@ -63,9 +70,64 @@ func (s *MySuite) TestValidateFlagsWithInvalidStrategy(c *C) {
 	c.Assert(err.Error(), Equals, "invalid input(sdf) to --strategy flag")
 }
 
+func (s *MySuite) TestValidateFlagsWithInvalidGranularity(c *C) {
+	InParallel = true
+	Strategy = "lazy"
+	NumberOfExecutionStreams = 1
+	ParallelGranularity = "step"
+	defer func() { ParallelGranularity = "" }()
+	err := validateFlags()
+	c.Assert(err.Error(), Equals, "invalid input(step) to --parallel-granularity flag")
+}
+
 func (s *MySuite) TestValidateFlagsWithInvalidStream(c *C) {
 	InParallel = true
 	NumberOfExecutionStreams = -1
 	err := validateFlags()
 	c.Assert(err.Error(), Equals, "invalid input(-1) to --n flag")
 }
+
+func (s *MySuite) TestValidateFlagsWithInvalidSortOrder(c *C) {
+	InParallel = false
+	order.SortOrder = "slowest"
+	defer func() { order.SortOrder = "" }()
+	err := validateFlags()
+	c.Assert(err.Error(), Equals, "invalid input(slowest) to --sort flag")
+}
+
+func (s *MySuite) TestValidateFlagsWithNegativeTimeBudget(c *C) {
+	InParallel = false
+	TimeBudget = -time.Minute
+	defer func() { TimeBudget = 0 }()
+	err := validateFlags()
+	c.Assert(err.Error(), Equals, "invalid input(-1m0s) to --time-budget flag")
+}
+
+func (s *MySuite) TestValidateFlagsWithInvalidRepeatCount(c *C) {
+	InParallel = false
+	RepeatCount = 0
+	defer func() { RepeatCount = 1 }()
+	err := validateFlags()
+	c.Assert(err.Error(), Equals, "invalid input(0) to --repeat-count flag")
+}
+
+func (s *MySuite) TestRankSpecsFromFailuresAndTimingsOfPreviousRuns(c *C) {
+	projectRoot := config.ProjectRoot
+	defer func() { config.ProjectRoot = projectRoot }()
+	config.ProjectRoot = c.MkDir()
+	dotGauge := filepath.Join(config.ProjectRoot, common.DotGauge)
+	c.Assert(os.MkdirAll(dotGauge, 0755), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dotGauge, "failures.json"), []byte(`{"Args":[],"FailedItems":["specs/c.spec:12","specs/a.spec","specs/c.spec:20"]}`), 0644), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dotGauge, "timings.json"), []byte(`{"specs":{"specs/a.spec":10,"specs/b.spec":300}}`), 0644), IsNil)
+
+	c.Assert(RankSpecs(order.FailedFirst), DeepEquals, []string{"specs/a.spec", "specs/c.spec"})
+	c.Assert(RankSpecs(order.DurationDesc), DeepEquals, []string{"specs/b.spec", "specs/a.spec"})
+}
+
+func (s *MySuite) TestStatusJSONHasQuarantinedScenarios(c *C) {
+	status := &executionStatus{}
+	c.Assert(json.Unmarshal([]byte(statusJSON(1, 0, 0, 0, 3, 1, 1, 0, 1)), status), IsNil)
+
+	c.Assert(status.SceFailed, Equals, 1)
+	c.Assert(status.SceQuarantined, Equals, 1)
+}
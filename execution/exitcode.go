@@ -15,4 +15,8 @@ const (
 	ParseFailed = 2
 	// ValidationFailed indicates one or more validation errors
 	ValidationFailed = 3
+	// MaxFailuresReached indicates execution was stopped early as the --max-failures limit was reached
+	MaxFailuresReached = 4
+	// Interrupted indicates execution was stopped early by SIGINT or SIGTERM
+	Interrupted = 130
 )
@@ -0,0 +1,102 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/getgauge/gauge/execution/result"
+)
+
+// MaxFailures is the number of failed scenarios after which no new specs are executed. Zero means no limit.
+var MaxFailures int
+
+// TimeBudget is the wall-clock time after which no new specs or scenarios are executed. Zero means no limit.
+var TimeBudget time.Duration
+
+var failedScenariosCount atomic.Int64
+
+// skippedForMaxFailures reports if any spec was skipped as the MaxFailures limit was reached.
+var skippedForMaxFailures atomic.Bool
+
+// deadline is the time by which the execution is to be completed as per TimeBudget. It is zero if there is no time budget.
+var deadline time.Time
+
+func resetFailedScenariosCount() {
+	failedScenariosCount.Store(0)
+	skippedForMaxFailures.Store(false)
+}
+
+func recordScenarioResult(r *result.ScenarioResult) {
+	if r.GetFailed() && !r.Quarantined {
+		failedScenariosCount.Add(1)
+	}
+}
+
+func startTimeBudget() {
+	deadline = time.Time{}
+	if TimeBudget > 0 {
+		deadline = time.Now().Add(TimeBudget)
+	}
+}
+
+func timeBudgetExceeded() bool {
+	return !deadline.IsZero() && time.Now().After(deadline)
+}
+
+func maxFailuresReached() bool {
+	return MaxFailures > 0 && failedScenariosCount.Load() >= int64(MaxFailures)
+}
+
+// haltCause is the cause for not starting the execution of any more specs or scenarios.
+type haltCause int
+
+const (
+	notHalted haltCause = iota
+	interruptedHalt
+	timeBudgetHalt
+	maxFailuresHalt
+)
+
+// reason gives the skip reason of the specs and scenarios not executed due to the cause.
+func (c haltCause) reason() string {
+	switch c {
+	case interruptedHalt:
+		return "Skipped Reason: Execution was interrupted"
+	case timeBudgetHalt:
+		return fmt.Sprintf("Skipped Reason: Time budget (%s) exceeded", TimeBudget)
+	case maxFailuresHalt:
+		return fmt.Sprintf("Skipped Reason: Maximum number of failures (%d) reached", MaxFailures)
+	}
+	return ""
+}
+
+// haltReason gives the cause for not starting the execution of any more specs.
+// It is notHalted if the execution can continue.
+func haltReason() haltCause {
+	if cause := scenarioHaltReason(); cause != notHalted {
+		return cause
+	}
+	if maxFailuresReached() {
+		return maxFailuresHalt
+	}
+	return notHalted
+}
+
+// scenarioHaltReason gives the cause for not starting the execution of any more scenarios, even within the spec in execution.
+// It is notHalted if the execution can continue.
+func scenarioHaltReason() haltCause {
+	if isInterrupted() {
+		return interruptedHalt
+	}
+	if timeBudgetExceeded() {
+		return timeBudgetHalt
+	}
+	return notHalted
+}
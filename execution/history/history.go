@@ -0,0 +1,283 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package history records the outcome of each scenario across runs, so that flaky scenarios can be identified.
+package history
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	historyFile = "history.json"
+	// maxRuns is the number of most recent outcomes retained for each scenario.
+	maxRuns = 20
+	// minFlips is the number of times the outcome of a scenario is to change across the runs for it to be flaky.
+	// A scenario whose outcome changed just once got broken or fixed.
+	minFlips = 2
+)
+
+// Outcome is the result of a scenario in a single run.
+type Outcome struct {
+	Failed bool `json:"failed"`
+	// RetriesCount is the number of times the scenario was executed in the run, including the first attempt.
+	RetriesCount int64 `json:"retriesCount"`
+	// Duration is the execution time in milliseconds.
+	Duration  int64  `json:"duration"`
+	ErrorHash string `json:"errorHash,omitempty"`
+}
+
+// Scenario holds the recent outcomes of a scenario, oldest first.
+type Scenario struct {
+	Spec        string     `json:"spec"`
+	Heading     string     `json:"heading"`
+	Line        int        `json:"line"`
+	SpecRow     int        `json:"specRow,omitempty"`
+	ScenarioRow int        `json:"scenarioRow,omitempty"`
+	Runs        []*Outcome `json:"runs"`
+}
+
+func (s *Scenario) key() string {
+	return fmt.Sprintf("%s|%s|%d|%d", s.Spec, s.Heading, s.SpecRow, s.ScenarioRow)
+}
+
+// Flaky tells if the outcome of the scenario alternated between passing and failing across runs, or if it passed only after a retry.
+func (s *Scenario) Flaky() bool {
+	return s.Flips() >= minFlips || s.PassedAfterRetry() > 0
+}
+
+// Flips returns the number of times the outcome of the scenario changed from one run to the next.
+func (s *Scenario) Flips() (n int) {
+	for i := 1; i < len(s.Runs); i++ {
+		if s.Runs[i].Failed != s.Runs[i-1].Failed {
+			n++
+		}
+	}
+	return
+}
+
+// Failures returns the number of runs in which the scenario failed.
+func (s *Scenario) Failures() (n int) {
+	for _, r := range s.Runs {
+		if r.Failed {
+			n++
+		}
+	}
+	return
+}
+
+// PassedAfterRetry returns the number of runs in which the scenario passed only after being retried.
+func (s *Scenario) PassedAfterRetry() (n int) {
+	for _, r := range s.Runs {
+		if !r.Failed && r.RetriesCount > 1 {
+			n++
+		}
+	}
+	return
+}
+
+// DistinctErrors returns the number of distinct error messages across the failed runs.
+func (s *Scenario) DistinctErrors() int {
+	hashes := make(map[string]bool)
+	for _, r := range s.Runs {
+		if r.Failed {
+			hashes[r.ErrorHash] = true
+		}
+	}
+	return len(hashes)
+}
+
+type history struct {
+	Scenarios []*Scenario `json:"scenarios"`
+}
+
+// ListenSuiteEndAndSaveHistory listens to the suite end event and records the outcome of the executed scenarios.
+func ListenSuiteEndAndSaveHistory(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				h := readHistory()
+				h.update(e.Result.(*result.SuiteResult))
+				writeHistory(h)
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func (h *history) update(res *result.SuiteResult) {
+	scenarios := make(map[string]*Scenario)
+	for _, s := range h.Scenarios {
+		scenarios[s.key()] = s
+	}
+	for _, r := range res.SpecResults {
+		if r.ProtoSpec == nil {
+			continue
+		}
+		spec := util.RelPathToProjectRoot(r.ProtoSpec.GetFileName())
+		for _, item := range r.ProtoSpec.GetItems() {
+			s, o, _ := scenarioOutcome(spec, item)
+			if s == nil {
+				continue
+			}
+			if existing, ok := scenarios[s.key()]; ok {
+				existing.Line = s.Line
+				s = existing
+			} else {
+				scenarios[s.key()] = s
+				h.Scenarios = append(h.Scenarios, s)
+			}
+			s.Runs = append(s.Runs, o)
+			if len(s.Runs) > maxRuns {
+				s.Runs = s.Runs[len(s.Runs)-maxRuns:]
+			}
+		}
+	}
+}
+
+// scenarioOutcome gives the scenario of the item and its outcome, along with the error messages if it failed.
+// It is nil if the item is not an executed scenario.
+func scenarioOutcome(spec string, item *gauge_messages.ProtoItem) (*Scenario, *Outcome, []string) {
+	var scn *gauge_messages.ProtoScenario
+	s := &Scenario{Spec: spec}
+	switch item.GetItemType() {
+	case gauge_messages.ProtoItem_Scenario:
+		scn = item.GetScenario()
+	case gauge_messages.ProtoItem_TableDrivenScenario:
+		tds := item.GetTableDrivenScenario()
+		scn = tds.GetScenario()
+		if tds.GetIsSpecTableDriven() {
+			s.SpecRow = int(tds.GetTableRowIndex()) + 1
+		}
+		if tds.GetIsScenarioTableDriven() {
+			s.ScenarioRow = int(tds.GetScenarioTableRowIndex()) + 1
+		}
+	default:
+		return nil, nil, nil
+	}
+	if scn.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED || scn.GetSkipped() {
+		return nil, nil, nil
+	}
+	s.Heading = scn.GetScenarioHeading()
+	s.Line = int(scn.GetSpan().GetStart())
+	o := &Outcome{Failed: scn.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED || scn.GetFailed(), RetriesCount: scn.GetRetriesCount(), Duration: scn.GetExecutionTime()}
+	var errs []string
+	if o.Failed {
+		errs = scenarioErrors(scn)
+		o.ErrorHash = errorHash(errs)
+	}
+	return s, o, errs
+}
+
+func scenarioErrors(scn *gauge_messages.ProtoScenario) (errs []string) {
+	if f := scn.GetPreHookFailure(); f != nil {
+		errs = append(errs, f.GetErrorMessage())
+	}
+	errs = append(errs, itemErrors(scn.GetContexts())...)
+	errs = append(errs, itemErrors(scn.GetScenarioItems())...)
+	errs = append(errs, itemErrors(scn.GetTearDownSteps())...)
+	if f := scn.GetPostHookFailure(); f != nil {
+		errs = append(errs, f.GetErrorMessage())
+	}
+	return
+}
+
+func itemErrors(items []*gauge_messages.ProtoItem) (errs []string) {
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Step:
+			res := item.GetStep().GetStepExecutionResult()
+			if f := res.GetPreHookFailure(); f != nil {
+				errs = append(errs, f.GetErrorMessage())
+			}
+			if res.GetExecutionResult().GetFailed() {
+				errs = append(errs, res.GetExecutionResult().GetErrorMessage())
+			}
+			if f := res.GetPostHookFailure(); f != nil {
+				errs = append(errs, f.GetErrorMessage())
+			}
+		case gauge_messages.ProtoItem_Concept:
+			errs = append(errs, itemErrors(item.GetConcept().GetSteps())...)
+		}
+	}
+	return
+}
+
+func errorHash(errs []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(errs, "\n")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// FlakyScenarios returns the flaky scenarios recorded in the execution history, most flaky first.
+func FlakyScenarios() []*Scenario {
+	var flaky []*Scenario
+	for _, s := range readHistory().Scenarios {
+		if s.Flaky() {
+			flaky = append(flaky, s)
+		}
+	}
+	sort.SliceStable(flaky, func(i, j int) bool {
+		return flaky[i].Flips()+flaky[i].PassedAfterRetry() > flaky[j].Flips()+flaky[j].PassedAfterRetry()
+	})
+	return flaky
+}
+
+func readHistory() *history {
+	h := &history{}
+	file := filepath.Join(config.ProjectRoot, common.DotGauge, historyFile)
+	if !common.FileExists(file) {
+		return h
+	}
+	contents, err := common.ReadFileContents(file)
+	if err != nil {
+		logger.Debugf(true, "Failed to read execution history. Reason: %s", err.Error())
+		return h
+	}
+	if err = json.Unmarshal([]byte(contents), h); err != nil {
+		logger.Debugf(true, "Ignoring invalid execution history in %s. Reason: %s", file, err.Error())
+		return &history{}
+	}
+	return h
+}
+
+func writeHistory(h *history) {
+	dotGaugeDir := filepath.Join(config.ProjectRoot, common.DotGauge)
+	file := filepath.Join(dotGaugeDir, historyFile)
+	if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, err.Error())
+		return
+	}
+	contents, err := json.MarshalIndent(h, "", "\t")
+	if err != nil {
+		logger.Errorf(true, "Failed to save execution history. Reason: %s", err.Error())
+		return
+	}
+	if err = os.WriteFile(file, contents, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", file, err.Error())
+	}
+}
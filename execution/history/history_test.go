@@ -0,0 +1,121 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package history
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+)
+
+func scenarioItem(heading string, status gauge_messages.ExecutionStatus, retries int64, errorMessage string) *gauge_messages.ProtoItem {
+	step := &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Step, Step: &gauge_messages.ProtoStep{
+		StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{ExecutionResult: &gauge_messages.ProtoExecutionResult{
+			Failed: errorMessage != "", ErrorMessage: errorMessage,
+		}},
+	}}
+	return &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{
+		ScenarioHeading: heading,
+		ExecutionStatus: status,
+		RetriesCount:    retries,
+		ExecutionTime:   10,
+		Span:            &gauge_messages.Span{Start: 3},
+		ScenarioItems:   []*gauge_messages.ProtoItem{step},
+	}}
+}
+
+func suiteResult(items ...*gauge_messages.ProtoItem) *result.SuiteResult {
+	spec := &gauge_messages.ProtoSpec{FileName: filepath.Join(config.ProjectRoot, "a.spec"), Items: items}
+	return &result.SuiteResult{SpecResults: []*result.SpecResult{{ProtoSpec: spec}}}
+}
+
+func TestHistoryIsRetainedAcrossRuns(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+
+	h := readHistory()
+	h.update(suiteResult(scenarioItem("Stable", gauge_messages.ExecutionStatus_PASSED, 1, ""), scenarioItem("Flips", gauge_messages.ExecutionStatus_PASSED, 1, "")))
+	writeHistory(h)
+	h = readHistory()
+	h.update(suiteResult(scenarioItem("Stable", gauge_messages.ExecutionStatus_PASSED, 1, ""), scenarioItem("Flips", gauge_messages.ExecutionStatus_FAILED, 1, "boom")))
+	writeHistory(h)
+	h = readHistory()
+	h.update(suiteResult(scenarioItem("Stable", gauge_messages.ExecutionStatus_PASSED, 1, ""), scenarioItem("Flips", gauge_messages.ExecutionStatus_PASSED, 1, "")))
+	writeHistory(h)
+
+	got := readHistory()
+	if len(got.Scenarios) != 2 || len(got.Scenarios[0].Runs) != 3 || len(got.Scenarios[1].Runs) != 3 {
+		t.Fatalf("Expected three runs of two scenarios. Got %v", got.Scenarios)
+	}
+	if got.Scenarios[1].Runs[1].ErrorHash == "" || got.Scenarios[1].Runs[0].ErrorHash != "" || got.Scenarios[1].Runs[2].ErrorHash != "" {
+		t.Errorf("Expected error hash only for the failed run. Got %v", got.Scenarios[1].Runs)
+	}
+
+	flaky := FlakyScenarios()
+	if len(flaky) != 1 || flaky[0].Heading != "Flips" || flaky[0].Spec != "a.spec" {
+		t.Errorf("Expected only Flips to be flaky. Got %v", flaky)
+	}
+}
+
+func TestScenarioPassedAfterRetryIsFlaky(t *testing.T) {
+	s := &Scenario{Runs: []*Outcome{{RetriesCount: 1}, {RetriesCount: 2}}}
+
+	if !s.Flaky() || s.PassedAfterRetry() != 1 {
+		t.Errorf("Expected scenario passing after a retry to be flaky")
+	}
+}
+
+func TestScenarioFailingConsistentlyIsNotFlaky(t *testing.T) {
+	s := &Scenario{Runs: []*Outcome{{Failed: true, RetriesCount: 3}, {Failed: true, RetriesCount: 3}}}
+
+	if s.Flaky() {
+		t.Errorf("Expected scenario failing in every run not to be flaky")
+	}
+}
+
+func TestScenarioChangingOutcomeOnceIsNotFlaky(t *testing.T) {
+	broken := &Scenario{Runs: []*Outcome{{RetriesCount: 1}, {RetriesCount: 1}, {Failed: true, RetriesCount: 1}, {Failed: true, RetriesCount: 1}}}
+	fixed := &Scenario{Runs: []*Outcome{{Failed: true, RetriesCount: 1}, {RetriesCount: 1}}}
+
+	if broken.Flaky() || fixed.Flaky() {
+		t.Errorf("Expected scenarios which got broken or fixed not to be flaky")
+	}
+}
+
+func TestScenarioAlternatingOutcomeIsFlaky(t *testing.T) {
+	s := &Scenario{Runs: []*Outcome{{RetriesCount: 1}, {Failed: true, RetriesCount: 1}, {RetriesCount: 1}, {RetriesCount: 1}}}
+
+	if !s.Flaky() || s.Flips() != 2 {
+		t.Errorf("Expected scenario alternating between passing and failing to be flaky. Got %d flips", s.Flips())
+	}
+}
+
+func TestSkippedScenariosAreNotRecorded(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+
+	h := &history{}
+	h.update(suiteResult(scenarioItem("Skipped", gauge_messages.ExecutionStatus_SKIPPED, 0, "")))
+
+	if len(h.Scenarios) != 0 {
+		t.Errorf("Expected skipped scenario not to be recorded. Got %v", h.Scenarios)
+	}
+}
+
+func TestOnlyRecentRunsAreRetained(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+
+	h := &history{}
+	for i := 0; i < maxRuns+5; i++ {
+		h.update(suiteResult(scenarioItem("Scenario", gauge_messages.ExecutionStatus_PASSED, 1, "")))
+	}
+
+	if len(h.Scenarios[0].Runs) != maxRuns {
+		t.Errorf("Expected %d runs. Got %d", maxRuns, len(h.Scenarios[0].Runs))
+	}
+}
@@ -0,0 +1,120 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package history
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/util"
+)
+
+// ScenarioStats holds the outcomes of a scenario over the repeated runs of an execution.
+type ScenarioStats struct {
+	Scenario
+	// Errors holds the distinct failure messages, in the order they first occurred.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// PassRate returns the percentage of runs in which the scenario passed.
+func (s *ScenarioStats) PassRate() float64 {
+	if len(s.Runs) == 0 {
+		return 0
+	}
+	return float64(len(s.Runs)-s.Failures()) * 100 / float64(len(s.Runs))
+}
+
+// MinDuration returns the shortest execution time in milliseconds across the runs.
+func (s *ScenarioStats) MinDuration() (d int64) {
+	for i, r := range s.Runs {
+		if i == 0 || r.Duration < d {
+			d = r.Duration
+		}
+	}
+	return
+}
+
+// MeanDuration returns the mean execution time in milliseconds across the runs.
+func (s *ScenarioStats) MeanDuration() int64 {
+	if len(s.Runs) == 0 {
+		return 0
+	}
+	var total int64
+	for _, r := range s.Runs {
+		total += r.Duration
+	}
+	return total / int64(len(s.Runs))
+}
+
+// MaxDuration returns the longest execution time in milliseconds across the runs.
+func (s *ScenarioStats) MaxDuration() (d int64) {
+	for _, r := range s.Runs {
+		d = max(d, r.Duration)
+	}
+	return
+}
+
+// MarshalJSON adds the pass rate and durations to the outcomes of the scenario.
+func (s *ScenarioStats) MarshalJSON() ([]byte, error) {
+	type scenarioStats ScenarioStats
+	return json.Marshal(struct {
+		*scenarioStats
+		PassRate     float64 `json:"passRate"`
+		MinDuration  int64   `json:"minDuration"`
+		MeanDuration int64   `json:"meanDuration"`
+		MaxDuration  int64   `json:"maxDuration"`
+	}{(*scenarioStats)(s), s.PassRate(), s.MinDuration(), s.MeanDuration(), s.MaxDuration()})
+}
+
+// Stats aggregates the outcomes of the scenarios over the repeated runs of an execution.
+type Stats struct {
+	Runs      int              `json:"runs"`
+	Scenarios []*ScenarioStats `json:"scenarios"`
+	index     map[string]*ScenarioStats
+}
+
+// NewStats creates the statistics of an execution which is yet to be run.
+func NewStats() *Stats {
+	return &Stats{index: make(map[string]*ScenarioStats)}
+}
+
+// Add records the outcome of the scenarios executed in a run.
+func (st *Stats) Add(res *result.SuiteResult) {
+	st.Runs++
+	for _, r := range res.SpecResults {
+		if r.ProtoSpec == nil {
+			continue
+		}
+		spec := util.RelPathToProjectRoot(r.ProtoSpec.GetFileName())
+		for _, item := range r.ProtoSpec.GetItems() {
+			scn, o, errs := scenarioOutcome(spec, item)
+			if scn == nil {
+				continue
+			}
+			s, ok := st.index[scn.key()]
+			if !ok {
+				s = &ScenarioStats{Scenario: *scn}
+				st.index[scn.key()] = s
+				st.Scenarios = append(st.Scenarios, s)
+			}
+			s.Runs = append(s.Runs, o)
+			if o.Failed {
+				s.addError(strings.Join(errs, "\n"))
+			}
+		}
+	}
+}
+
+func (s *ScenarioStats) addError(err string) {
+	for _, e := range s.Errors {
+		if e == err {
+			return
+		}
+	}
+	s.Errors = append(s.Errors, err)
+}
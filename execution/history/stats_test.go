@@ -0,0 +1,44 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package history
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+)
+
+func TestStatsAggregateOutcomesAcrossRuns(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	stats := NewStats()
+
+	slow := scenarioItem("Flips", gauge_messages.ExecutionStatus_PASSED, 1, "")
+	slow.Scenario.ExecutionTime = 40
+	stats.Add(suiteResult(scenarioItem("Stable", gauge_messages.ExecutionStatus_PASSED, 1, ""), slow))
+	stats.Add(suiteResult(scenarioItem("Stable", gauge_messages.ExecutionStatus_PASSED, 1, ""), scenarioItem("Flips", gauge_messages.ExecutionStatus_FAILED, 1, "boom")))
+	stats.Add(suiteResult(scenarioItem("Stable", gauge_messages.ExecutionStatus_PASSED, 1, ""), scenarioItem("Flips", gauge_messages.ExecutionStatus_FAILED, 1, "boom")))
+	stats.Add(suiteResult(scenarioItem("Stable", gauge_messages.ExecutionStatus_PASSED, 1, ""), scenarioItem("Flips", gauge_messages.ExecutionStatus_FAILED, 1, "timeout")))
+
+	if stats.Runs != 4 || len(stats.Scenarios) != 2 {
+		t.Fatalf("Expected 2 scenarios over 4 runs. Got %d scenarios over %d runs", len(stats.Scenarios), stats.Runs)
+	}
+	stable, flips := stats.Scenarios[0], stats.Scenarios[1]
+	if stable.PassRate() != 100 || len(stable.Errors) != 0 {
+		t.Errorf("Expected Stable to pass in all runs. Got %v%% with errors %v", stable.PassRate(), stable.Errors)
+	}
+	if flips.PassRate() != 25 {
+		t.Errorf("Expected Flips to pass in 25%% of the runs. Got %v", flips.PassRate())
+	}
+	if flips.MinDuration() != 10 || flips.MeanDuration() != 17 || flips.MaxDuration() != 40 {
+		t.Errorf("Expected durations 10/17/40. Got %d/%d/%d", flips.MinDuration(), flips.MeanDuration(), flips.MaxDuration())
+	}
+	if !reflect.DeepEqual(flips.Errors, []string{"boom", "timeout"}) {
+		t.Errorf("Expected distinct failure messages. Got %v", flips.Errors)
+	}
+}
@@ -0,0 +1,69 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/runner"
+)
+
+var interrupted atomic.Bool
+
+func isInterrupted() bool {
+	return interrupted.Load()
+}
+
+// interruptGracePeriod is how long the execution is waited for to wind up after an interrupt, before exiting without it.
+var interruptGracePeriod = 2 * time.Minute
+
+// handleInterrupts stops the execution gracefully on SIGINT or SIGTERM. No new scenarios are started once a signal is received,
+// the scenarios in execution are allowed to finish and the hooks, plugins and reports are run as usual.
+// A second signal, or the execution not winding up within the grace period, kills all the runners and exits immediately.
+// So does SIGHUP, as the terminal is lost. The returned function stops listening for signals.
+func handleInterrupts() func() {
+	interrupted.Store(false)
+	ch := make(chan os.Signal, 2)
+	done := make(chan struct{})
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	go func() {
+		select {
+		case s := <-ch:
+			if s == syscall.SIGHUP {
+				exitNow("Received %s, exiting without waiting for the execution to finish.", s)
+			}
+			interrupted.Store(true)
+			logger.Warningf(true, "Received %s, stopping the execution after the scenarios in execution. Send it again to exit immediately.", s)
+		case <-done:
+			return
+		}
+		timer := time.NewTimer(interruptGracePeriod)
+		defer timer.Stop()
+		select {
+		case <-ch:
+			exitNow("Exiting without waiting for the execution to finish.")
+		case <-timer.C:
+			exitNow("Exiting as the execution did not wind up within %s of the interrupt.", interruptGracePeriod)
+		case <-done:
+		}
+	}()
+	return func() {
+		signal.Stop(ch)
+		close(done)
+	}
+}
+
+func exitNow(message string, args ...interface{}) {
+	logger.Errorf(true, message, args...)
+	runner.KillAll()
+	os.Exit(Interrupted)
+}
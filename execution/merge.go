@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	m "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/quarantine"
 	"github.com/getgauge/gauge/execution/result"
 )
 
@@ -41,6 +42,9 @@ func mergeDataTableSpecResults(sResult *result.SuiteResult) *result.SuiteResult
 	for _, res := range combinedResults {
 		mergedRes := res[0]
 		if len(res) > 1 {
+			if isScenarioGranularity() || len(resumedResults) > 0 {
+				sortByScenarioPosition(res)
+			}
 			mergedRes = mergeResults(res)
 		}
 		if mergedRes.GetFailed() {
@@ -89,7 +93,7 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 			switch item.ItemType {
 			case m.ProtoItem_Scenario:
 				scnResults = append(scnResults, item)
-				modifySpecStats(item.Scenario, specResult)
+				modifySpecStats(item.Scenario, res.ProtoSpec, specResult)
 			case m.ProtoItem_TableDrivenScenario:
 				tableRowIndex := item.TableDrivenScenario.TableRowIndex
 				if _, ok := includedTableRowIndexMap[tableRowIndex]; !ok {
@@ -114,7 +118,7 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 	if InParallel {
 		specResult.ExecutionTime = max
 	}
-	aggregateDataTableScnStats(dataTableScnResults, specResult)
+	aggregateDataTableScnStats(dataTableScnResults, results[0].ProtoSpec, specResult)
 	specResult.ProtoSpec.FileName = results[0].ProtoSpec.FileName
 	specResult.ProtoSpec.Tags = results[0].ProtoSpec.Tags
 	specResult.ProtoSpec.SpecHeading = results[0].ProtoSpec.SpecHeading
@@ -146,11 +150,13 @@ func getItems(table *m.ProtoTable, scnResults []*m.ProtoItem, results []*result.
 	return
 }
 
-func aggregateDataTableScnStats(results map[string][]*m.ProtoTableDrivenScenario, specResult *result.SpecResult) {
+func aggregateDataTableScnStats(results map[string][]*m.ProtoTableDrivenScenario, spec *m.ProtoSpec, specResult *result.SpecResult) {
 	for _, dResult := range results {
 		for _, res := range dResult {
 			isTableIndicesExcluded := false
-			if res.Scenario.ExecutionStatus == m.ExecutionStatus_FAILED {
+			if isQuarantinedFailure(res.Scenario, spec) {
+				specResult.ScenarioQuarantinedCount++
+			} else if res.Scenario.ExecutionStatus == m.ExecutionStatus_FAILED {
 				specResult.ScenarioFailedCount++
 			} else if res.Scenario.ExecutionStatus == m.ExecutionStatus_SKIPPED &&
 				!strings.Contains(res.Scenario.SkipErrors[0], "--table-rows") {
@@ -167,12 +173,18 @@ func aggregateDataTableScnStats(results map[string][]*m.ProtoTableDrivenScenario
 	}
 }
 
-func modifySpecStats(scn *m.ProtoScenario, specRes *result.SpecResult) {
-	switch scn.ExecutionStatus {
-	case m.ExecutionStatus_SKIPPED:
+func modifySpecStats(scn *m.ProtoScenario, spec *m.ProtoSpec, specRes *result.SpecResult) {
+	switch {
+	case scn.ExecutionStatus == m.ExecutionStatus_SKIPPED:
 		specRes.ScenarioSkippedCount++
-	case m.ExecutionStatus_FAILED:
+	case isQuarantinedFailure(scn, spec):
+		specRes.ScenarioQuarantinedCount++
+	case scn.ExecutionStatus == m.ExecutionStatus_FAILED:
 		specRes.ScenarioFailedCount++
 	}
 	specRes.ScenarioCount++
 }
+
+func isQuarantinedFailure(scn *m.ProtoScenario, spec *m.ProtoSpec) bool {
+	return scn.ExecutionStatus == m.ExecutionStatus_FAILED && quarantine.Contains(spec.GetFileName(), spec.GetTags(), scn.GetScenarioHeading(), scn.GetTags())
+}
@@ -35,7 +35,7 @@ func TestModifySpecStats(t *testing.T) {
 	for _, test := range statsTests {
 		res := &result.SpecResult{}
 
-		modifySpecStats(&gm.ProtoScenario{ExecutionStatus: test.status}, res)
+		modifySpecStats(&gm.ProtoScenario{ExecutionStatus: test.status}, &gm.ProtoSpec{}, res)
 		got := stat{failed: res.ScenarioFailedCount, skipped: res.ScenarioSkippedCount, total: res.ScenarioCount}
 
 		if !reflect.DeepEqual(got, test.want) {
@@ -63,7 +63,7 @@ func TestAggregateDataTableScnStats(t *testing.T) {
 		"heading4": {{Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED}}},
 	}
 
-	aggregateDataTableScnStats(scns, res)
+	aggregateDataTableScnStats(scns, &gm.ProtoSpec{}, res)
 
 	got := stat{failed: res.ScenarioFailedCount, skipped: res.ScenarioSkippedCount, total: res.ScenarioCount}
 	want := stat{failed: 2, skipped: 1, total: 5}
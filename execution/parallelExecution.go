@@ -22,6 +22,7 @@ import (
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/execution/timing"
 	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
@@ -39,6 +40,15 @@ const Eager string = "eager"
 // Lazy is a parallelization strategy for execution. In this case tests assignment will be dynamic during execution, i.e. assign the next spec in line to the stream that has completed it’s previous execution and is waiting for more work.
 const Lazy string = "lazy"
 
+// ParallelGranularity is the unit of work distributed among the parallel streams, can be either 'spec' or 'scenario'
+var ParallelGranularity string
+
+// SpecGranularity distributes whole specs among the parallel streams.
+const SpecGranularity string = "spec"
+
+// ScenarioGranularity splits the scenarios of a spec among the parallel streams. Every stream executing a part of a spec runs the spec hooks, contexts and teardowns.
+const ScenarioGranularity string = "scenario"
+
 const (
 	gaugeAPIPortsEnv            = "GAUGE_API_PORTS"
 	gaugeParallelStreamCountEnv = "GAUGE_PARALLEL_STREAMS_COUNT"
@@ -120,20 +130,30 @@ func (e *parallelExecution) startRunnersForRemainingStreams() {
 func (e *parallelExecution) run() *result.SuiteResult {
 	e.start()
 	var res []*result.SuiteResult
+	var serialSpecs []*gauge.Specification
 	if env.AllowFilteredParallelExecution() && e.tagsToFilter != "" {
-		parallesSpecs, serialSpecs := filter.FilterSpecForParallelRun(e.specCollection.Specs(), e.tagsToFilter)
+		var parallesSpecs []*gauge.Specification
+		parallesSpecs, serialSpecs = filter.FilterSpecForParallelRun(e.specCollection.Specs(), e.tagsToFilter)
 		if Verbose {
 			logger.Infof(true, "Applied tags '%s' to filter specs for parallel execution", e.tagsToFilter)
 			logger.Infof(true, "No of specs to be executed in serial : %d", len(serialSpecs))
 			logger.Infof(true, "No of specs to be executed in parallel : %d", len(parallesSpecs))
 		}
 		if len(serialSpecs) > 0 {
-			logger.Infof(true, "Executing %d specs in serial.", len(serialSpecs))
 			e.specCollection = gauge.NewSpecCollection(parallesSpecs, false)
-			res = append(res, e.executeSpecsInSerial(gauge.NewSpecCollection(serialSpecs, true)))
 		}
 	}
-
+	if isScenarioGranularity() {
+		e.specCollection = gauge.NewSpecCollection(splitSpecsByScenarios(e.specCollection.Specs(), e.numberOfExecutionStreams, e.errMaps), false)
+	}
+	// specs executed in serial do not wait for the specs they depend on among the ones executed in parallel afterwards.
+	outcomes = newSpecOutcomes(append(append([]*gauge.Specification{}, serialSpecs...), e.specCollection.Specs()...))
+	if len(serialSpecs) > 0 {
+		logger.Infof(true, "Executing %d specs in serial.", len(serialSpecs))
+		outcomes.expect(serialSpecs)
+		res = append(res, e.executeSpecsInSerial(gauge.NewSpecCollection(serialSpecs, true)))
+	}
+	outcomes.expect(e.specCollection.Specs())
 	if e.specCollection.Size() > 0 {
 		logger.Infof(true, "Executing in %d parallel streams.", e.numberOfStreams())
 		// skipcq CRT-A0013
@@ -223,7 +243,7 @@ func (e *parallelExecution) startMultithreaded(r runner.Runner, resChan chan *re
 func (e *parallelExecution) executeEagerly() {
 	defer close(e.resultChan)
 	distributions := e.numberOfStreams()
-	specs := filter.DistributeSpecs(e.specCollection.Specs(), distributions)
+	specs := filter.DistributeSpecs(e.specCollection.Specs(), distributions, timing.SpecDurations())
 	e.wg.Add(distributions)
 	e.startRunnersForRemainingStreams()
 
@@ -257,7 +277,7 @@ func (e *parallelExecution) startSpecsExecutionWithRunner(s *gauge.SpecCollectio
 	executionInfo := newExecutionInfo(s, runner, e.pluginHandler, e.errMaps, false, stream)
 	se := newSimpleExecution(executionInfo, false, false)
 	se.execute()
-	err := runner.Kill()
+	err := se.runner.Kill()
 	if err != nil {
 		logger.Errorf(true, "Failed to kill runner. %s", err.Error())
 	}
@@ -272,7 +292,7 @@ func (e *parallelExecution) executeSpecsInSerial(s *gauge.SpecCollection) *resul
 	executionInfo := newExecutionInfo(s, runner, e.pluginHandler, e.errMaps, false, 1)
 	se := newSimpleExecution(executionInfo, false, false)
 	se.execute()
-	er := runner.Kill()
+	er := se.runner.Kill()
 	if er != nil {
 		logger.Errorf(true, "Failed to kill runner. %s", er.Error())
 	}
@@ -281,7 +301,7 @@ func (e *parallelExecution) executeSpecsInSerial(s *gauge.SpecCollection) *resul
 }
 
 func (e *parallelExecution) finish() {
-	e.suiteResult = mergeDataTableSpecResults(e.suiteResult)
+	e.suiteResult = mergeDataTableSpecResults(withResumedResults(e.suiteResult))
 	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, e.suiteResult, 0, &gauge_messages.ExecutionInfo{}))
 	message := &gauge_messages.Message{
 		MessageType: gauge_messages.Message_SuiteExecutionResult,
@@ -330,6 +350,15 @@ func isLazy() bool {
 	return strings.ToLower(Strategy) == Lazy
 }
 
+func isScenarioGranularity() bool {
+	return strings.ToLower(ParallelGranularity) == ScenarioGranularity
+}
+
+func isValidGranularity(granularity string) bool {
+	granularity = strings.ToLower(granularity)
+	return granularity == "" || granularity == SpecGranularity || granularity == ScenarioGranularity
+}
+
 func isValidStrategy(strategy string) bool {
 	strategy = strings.ToLower(strategy)
 	return strategy == Lazy || strategy == Eager
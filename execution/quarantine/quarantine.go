@@ -0,0 +1,90 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package quarantine reads the known failing scenarios of a project. Quarantined scenarios are executed and reported,
+// but their failures do not fail the execution.
+package quarantine
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/util"
+)
+
+// File is the name of the quarantine file in the project root.
+const File = "quarantine.json"
+
+// Tag is added to the tags of the failed scenarios which are quarantined, so that the plugins can tell them apart
+// from the other failures.
+const Tag = "quarantined"
+
+// scenario identifies a scenario by its spec path, relative to the project root, and its heading.
+type scenario struct {
+	Spec    string `json:"spec"`
+	Heading string `json:"heading"`
+}
+
+type quarantine struct {
+	Scenarios []scenario `json:"scenarios"`
+	Tags      []string   `json:"tags"`
+}
+
+var current = &quarantine{}
+
+// Load reads the quarantine file of the project. Nothing is quarantined if the file does not exist.
+func Load() error {
+	current = &quarantine{}
+	file := filepath.Join(config.ProjectRoot, File)
+	if !common.FileExists(file) {
+		return nil
+	}
+	contents, err := common.ReadFileContents(file)
+	if err != nil {
+		return fmt.Errorf("Failed to read quarantine file %s. Reason: %s", file, err.Error())
+	}
+	q := &quarantine{}
+	if err = json.Unmarshal([]byte(contents), q); err != nil {
+		return fmt.Errorf("Invalid quarantine file %s. Reason: %s", file, err.Error())
+	}
+	for i, s := range q.Scenarios {
+		q.Scenarios[i].Spec = filepath.Clean(filepath.FromSlash(s.Spec))
+		q.Scenarios[i].Heading = strings.TrimSpace(s.Heading)
+	}
+	current = q
+	return nil
+}
+
+// Contains tells if a scenario is quarantined, either by its spec path and heading, or by one of its tags or its spec's tags.
+func Contains(specFile string, specTags []string, heading string, scenarioTags []string) bool {
+	spec := filepath.Clean(util.RelPathToProjectRoot(specFile))
+	heading = strings.TrimSpace(heading)
+	for _, s := range current.Scenarios {
+		if s.Spec == spec && s.Heading == heading {
+			return true
+		}
+	}
+	for _, t := range current.Tags {
+		if hasTag(specTags, t) || hasTag(scenarioTags, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.TrimSpace(t) == strings.TrimSpace(tag) || (!env.AllowCaseSensitiveTags() && strings.EqualFold(strings.TrimSpace(t), strings.TrimSpace(tag))) {
+			return true
+		}
+	}
+	return false
+}
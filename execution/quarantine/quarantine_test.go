@@ -0,0 +1,80 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package quarantine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+)
+
+func writeQuarantine(t *testing.T, contents string) {
+	config.ProjectRoot = t.TempDir()
+	if err := os.WriteFile(filepath.Join(config.ProjectRoot, File), []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestContainsScenarioBySpecAndHeading(t *testing.T) {
+	writeQuarantine(t, `{"scenarios": [{"spec": "specs/login.spec", "heading": "Login with expired password"}]}`)
+	if err := Load(); err != nil {
+		t.Fatal(err)
+	}
+	spec := filepath.Join(config.ProjectRoot, "specs", "login.spec")
+
+	if !Contains(spec, nil, "Login with expired password", nil) {
+		t.Errorf("Expected scenario to be quarantined")
+	}
+	if Contains(spec, nil, "Login with valid password", nil) {
+		t.Errorf("Expected other scenarios of the spec not to be quarantined")
+	}
+	if Contains(filepath.Join(config.ProjectRoot, "specs", "other.spec"), nil, "Login with expired password", nil) {
+		t.Errorf("Expected scenarios of other specs not to be quarantined")
+	}
+}
+
+func TestContainsScenarioByTag(t *testing.T) {
+	writeQuarantine(t, `{"tags": ["known-bug"]}`)
+	if err := Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !Contains("a.spec", nil, "Scenario", []string{"smoke", "Known-Bug"}) {
+		t.Errorf("Expected scenario with quarantined tag to be quarantined")
+	}
+	if !Contains("a.spec", []string{"known-bug"}, "Scenario", nil) {
+		t.Errorf("Expected scenario of spec with quarantined tag to be quarantined")
+	}
+	if Contains("a.spec", []string{"smoke"}, "Scenario", []string{"regression"}) {
+		t.Errorf("Expected scenario without quarantined tags not to be quarantined")
+	}
+}
+
+func TestLoadWithoutQuarantineFile(t *testing.T) {
+	writeQuarantine(t, `{"tags": ["known-bug"]}`)
+	if err := Load(); err != nil {
+		t.Fatal(err)
+	}
+	config.ProjectRoot = t.TempDir()
+
+	if err := Load(); err != nil {
+		t.Fatal(err)
+	}
+	if Contains("a.spec", nil, "Scenario", []string{"known-bug"}) {
+		t.Errorf("Expected nothing to be quarantined without a quarantine file")
+	}
+}
+
+func TestLoadWithInvalidQuarantineFile(t *testing.T) {
+	writeQuarantine(t, `{"tags": [`)
+
+	if err := Load(); err == nil {
+		t.Errorf("Expected an error for invalid quarantine file")
+	}
+}
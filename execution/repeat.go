@@ -0,0 +1,147 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/history"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/validation"
+)
+
+const repeatStatsFile = "repeat-stats.json"
+
+// RepeatCount is the number of times the specs are executed, to report the statistics of each scenario across the runs.
+var RepeatCount = 1
+
+// repeatStats aggregates the results of the runs, while the specs are executed repeatedly.
+var repeatStats *history.Stats
+
+// executeRepeatedly executes the validated specs as many times as asked, using the same runner,
+// and reports the pass rate, duration and distinct failures of each scenario across the runs.
+// The time budget applies to all the runs together, so the runs are stopped once it is exceeded.
+func executeRepeatedly(res *validation.ValidationResult, specDirs []string) int {
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		logger.Fatal(true, err.Error())
+	}
+	r := newRestartableRunner(res.Runner, m, 0)
+	defer func() {
+		if err := r.Kill(); err != nil {
+			logger.Errorf(true, "Failed to kill Runner: %s", err.Error())
+		}
+	}()
+	repeatStats = history.NewStats()
+	defer func() { repeatStats = nil }()
+	specs := res.SpecCollection.Specs()
+	exitCode := Success
+	for i := 1; i <= RepeatCount; i++ {
+		logger.Infof(true, "\nRun %d of %d", i, RepeatCount)
+		if !r.Alive() {
+			if err := r.restart(); err != nil {
+				logger.Errorf(true, "Failed to restart runner. %s", err.Error())
+				exitCode = ExecutionFailed
+				break
+			}
+		}
+		res.Runner = &keepAliveRunner{r}
+		res.SpecCollection = gauge.NewSpecCollection(specs, false)
+		if code := executeValidatedSpecs(res, specDirs); code != Success {
+			exitCode = code
+		}
+		if isInterrupted() {
+			break
+		}
+		if i < RepeatCount && timeBudgetExceeded() {
+			logger.Infof(true, "\nNot starting the remaining runs as the time budget (%s) is exceeded.", TimeBudget)
+			break
+		}
+	}
+	printRepeatStats(repeatStats)
+	writeRepeatStats(repeatStats)
+	return exitCode
+}
+
+func listenSuiteEndAndCollectRepeatStats(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				repeatStats.Add(e.Result.(*result.SuiteResult))
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func printRepeatStats(stats *history.Stats) {
+	logger.Infof(true, "\nScenarios across %d runs:", stats.Runs)
+	for _, s := range stats.Scenarios {
+		logger.Info(true, repeatStatsText(s))
+	}
+}
+
+func repeatStatsText(s *history.ScenarioStats) string {
+	text := fmt.Sprintf("%s:%d %s", s.Spec, s.Line, s.Heading)
+	if s.SpecRow > 0 {
+		text += fmt.Sprintf(" [spec row %d]", s.SpecRow)
+	}
+	if s.ScenarioRow > 0 {
+		text += fmt.Sprintf(" [scenario row %d]", s.ScenarioRow)
+	}
+	text += fmt.Sprintf("\n  passed %d of %d runs (%.1f%%), took %s min, %s mean, %s max", len(s.Runs)-s.Failures(), len(s.Runs), s.PassRate(),
+		time.Millisecond*time.Duration(s.MinDuration()), time.Millisecond*time.Duration(s.MeanDuration()), time.Millisecond*time.Duration(s.MaxDuration()))
+	for _, err := range s.Errors {
+		text += "\n  failed with: " + strings.ReplaceAll(err, "\n", "\n    ")
+	}
+	return text
+}
+
+// writeRepeatStats writes the statistics to the reports directory, so that they can be processed by other tools.
+func writeRepeatStats(stats *history.Stats) {
+	dir := os.Getenv(env.GaugeReportsDir)
+	if dir == "" {
+		dir = "reports"
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(config.ProjectRoot, dir)
+	}
+	if err := os.MkdirAll(dir, common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dir, err.Error())
+		return
+	}
+	contents, err := json.MarshalIndent(stats, "", "\t")
+	if err != nil {
+		logger.Errorf(true, "Failed to convert the statistics of the runs to JSON. Reason: %s", err.Error())
+		return
+	}
+	file := filepath.Join(dir, repeatStatsFile)
+	if err = os.WriteFile(file, contents, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", file, err.Error())
+		return
+	}
+	logger.Infof(true, "Statistics of the runs written to %s", file)
+}
@@ -12,6 +12,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/getgauge/common"
@@ -101,6 +103,7 @@ func ListenFailedScenarios(wg *sync.WaitGroup, specDirs []string) {
 				failedMeta.aggregateFailedItems()
 				writeFailedMeta(getJSON(failedMeta))
 				wg.Done()
+				return
 			}
 		}
 	}()
@@ -228,3 +231,40 @@ var WritePrevArgs = func(cmdArgs []string) {
 		logger.Fatalf(true, "Failed to write to %s. Reason: %s", prevCmdFile, err.Error())
 	}
 }
+
+// FailedSpecs returns the paths, relative to the project root, of the specs which failed in the last run.
+// It is empty if the last run did not record any failures.
+func FailedSpecs() []string {
+	file := filepath.Join(config.ProjectRoot, common.DotGauge, failedFile)
+	if !common.FileExists(file) {
+		return nil
+	}
+	contents, err := common.ReadFileContents(file)
+	if err != nil {
+		logger.Debugf(true, "Failed to read last run information. Reason: %s", err.Error())
+		return nil
+	}
+	meta := newFailedMetaData()
+	if err = json.Unmarshal([]byte(contents), meta); err != nil {
+		logger.Debugf(true, "Ignoring invalid last run information. Reason: %s", err.Error())
+		return nil
+	}
+	seen := make(map[string]bool)
+	var specs []string
+	for _, item := range meta.FailedItems {
+		spec := item
+		if i := strings.LastIndex(item, ":"); i > 0 && isLineNumber(item[i+1:]) {
+			spec = item[:i]
+		}
+		if !seen[spec] {
+			seen[spec] = true
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
+
+func isLineNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
@@ -0,0 +1,175 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/runner"
+)
+
+// restartableRunner wraps the runner of an execution stream, so that the runner process
+// can be replaced mid execution without the executors having to know about it.
+type restartableRunner struct {
+	mutex    sync.RWMutex
+	current  runner.Runner
+	manifest *manifest.Manifest
+	stream   int
+	// suiteHook and specHook are the before suite and before spec hooks executed by the runner, for the suite and the spec
+	// in execution. They are executed again by a restarted runner, to set up the state lost along with the old runner.
+	suiteHook *gauge_messages.Message
+	specHook  *gauge_messages.Message
+}
+
+var startNewRunner = runner.Start
+
+func newRestartableRunner(r runner.Runner, m *manifest.Manifest, stream int) *restartableRunner {
+	return &restartableRunner{current: r, manifest: m, stream: stream}
+}
+
+// asRestartable gives the restartable runner behind the given runner, if any.
+func asRestartable(r runner.Runner) (*restartableRunner, bool) {
+	if k, ok := r.(*keepAliveRunner); ok {
+		r = k.Runner
+	}
+	rr, ok := r.(*restartableRunner)
+	return rr, ok
+}
+
+func (r *restartableRunner) get() runner.Runner {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.current
+}
+
+// restart kills the current runner process, starts a new one, initialises its data stores and executes the before suite
+// and before spec hooks of the suite and the spec in execution again.
+func (r *restartableRunner) restart() error {
+	logger.Warningf(true, "Restarting runner for stream %d.", r.stream)
+	forceKill(r.get())
+	nr, err := startNewRunner(r.manifest, r.stream, make(chan bool), false)
+	if err != nil {
+		return err
+	}
+	r.mutex.Lock()
+	r.current = nr
+	hooks := []*gauge_messages.Message{r.suiteHook, r.specHook}
+	r.mutex.Unlock()
+	for _, m := range dataStoreInitMessages(r.stream) {
+		if res := nr.ExecuteAndGetStatus(m); res.GetFailed() {
+			return fmt.Errorf("failed to initialize data store after restart. Error: %s", res.GetErrorMessage())
+		}
+	}
+	for _, m := range hooks {
+		if m == nil {
+			continue
+		}
+		logger.Infof(true, "Executing %s hooks again on the restarted runner for stream %d.", hookName(m), r.stream)
+		if res := nr.ExecuteAndGetStatus(m); res.GetFailed() {
+			return fmt.Errorf("failed to execute %s hooks after restart. Error: %s", hookName(m), res.GetErrorMessage())
+		}
+	}
+	return nil
+}
+
+// recordHook keeps track of the before suite and before spec hooks executed by the runner, till their after hooks are executed.
+func (r *restartableRunner) recordHook(m *gauge_messages.Message) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	switch m.MessageType {
+	case gauge_messages.Message_ExecutionStarting:
+		r.suiteHook = m
+	case gauge_messages.Message_SpecExecutionStarting:
+		r.specHook = m
+	case gauge_messages.Message_SpecExecutionEnding:
+		r.specHook = nil
+	case gauge_messages.Message_ExecutionEnding:
+		r.suiteHook, r.specHook = nil, nil
+	}
+}
+
+func hookName(m *gauge_messages.Message) string {
+	if m.MessageType == gauge_messages.Message_ExecutionStarting {
+		return "before suite"
+	}
+	return "before spec"
+}
+
+func dataStoreInitMessages(stream int) []*gauge_messages.Message {
+	s := int32(stream)
+	return []*gauge_messages.Message{
+		{MessageType: gauge_messages.Message_SuiteDataStoreInit, SuiteDataStoreInitRequest: &gauge_messages.SuiteDataStoreInitRequest{Stream: s}},
+		{MessageType: gauge_messages.Message_SpecDataStoreInit, SpecDataStoreInitRequest: &gauge_messages.SpecDataStoreInitRequest{Stream: s}},
+		{MessageType: gauge_messages.Message_ScenarioDataStoreInit, ScenarioDataStoreInitRequest: &gauge_messages.ScenarioDataStoreInitRequest{Stream: s}},
+	}
+}
+
+// forceKill asks the runner to stop, and kills its process if it does not. Every runner of a group is killed.
+func forceKill(r runner.Runner) {
+	if g, ok := r.(runner.RunnerGroup); ok {
+		for _, gr := range g.Runners() {
+			forceKill(gr)
+		}
+		return
+	}
+	if err := r.Kill(); err == nil && !r.Alive() {
+		return
+	}
+	p, err := os.FindProcess(r.Pid())
+	if err != nil {
+		return
+	}
+	if err := p.Kill(); err != nil {
+		logger.Debugf(true, "Unable to kill runner with pid %d. %s", r.Pid(), err.Error())
+	}
+}
+
+func (r *restartableRunner) ExecuteAndGetStatus(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+	r.recordHook(m)
+	return r.get().ExecuteAndGetStatus(m)
+}
+
+func (r *restartableRunner) ExecuteMessageWithTimeout(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+	return r.get().ExecuteMessageWithTimeout(m)
+}
+
+func (r *restartableRunner) Alive() bool {
+	return r.get().Alive()
+}
+
+func (r *restartableRunner) Kill() error {
+	return r.get().Kill()
+}
+
+func (r *restartableRunner) Connection() net.Conn {
+	return r.get().Connection()
+}
+
+func (r *restartableRunner) IsMultithreaded() bool {
+	return r.get().IsMultithreaded()
+}
+
+func (r *restartableRunner) Info() *runner.RunnerInfo {
+	return r.get().Info()
+}
+
+func (r *restartableRunner) Implementations(stepValue string) []string {
+	if sr, ok := r.get().(runner.StepRouter); ok {
+		return sr.Implementations(stepValue)
+	}
+	return nil
+}
+
+func (r *restartableRunner) Pid() int {
+	return r.get().Pid()
+}
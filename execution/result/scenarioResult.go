@@ -15,6 +15,8 @@ type ScenarioResult struct {
 	ScenarioDataTableRow      *gauge_messages.ProtoTable
 	ScenarioDataTableRowIndex int
 	ScenarioDataTable         *gauge_messages.ProtoTable
+	// Quarantined is true if the scenario is a known failure, whose failure does not fail its spec or the suite.
+	Quarantined bool
 }
 
 func NewScenarioResult(sce *gauge_messages.ProtoScenario) *ScenarioResult {
@@ -31,6 +33,11 @@ func (s ScenarioResult) GetFailed() bool {
 	return s.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED
 }
 
+// GetQuarantinedFailure tells if the scenario failed and is quarantined
+func (s ScenarioResult) GetQuarantinedFailure() bool {
+	return s.Quarantined && s.GetFailed()
+}
+
 func (s ScenarioResult) SetSkippedScenario() {
 	s.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
 }
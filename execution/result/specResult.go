@@ -20,7 +20,9 @@ type SpecResult struct {
 	ExecutionTime        int64
 	Skipped              bool
 	ScenarioSkippedCount int
-	Errors               []*gauge_messages.Error
+	// ScenarioQuarantinedCount is the number of failed scenarios which are quarantined. They are not included in ScenarioFailedCount.
+	ScenarioQuarantinedCount int
+	Errors                   []*gauge_messages.Error
 }
 
 // SetFailure sets the result to failed
@@ -39,7 +41,9 @@ func (specResult *SpecResult) AddSpecItems(resolvedItems []*gauge_messages.Proto
 // AddScenarioResults adds the scenario result to the spec result.
 func (specResult *SpecResult) AddScenarioResults(scenarioResults []Result) {
 	for _, scenarioResult := range scenarioResults {
-		if scenarioResult.GetFailed() {
+		if isQuarantinedFailure(scenarioResult) {
+			specResult.ScenarioQuarantinedCount++
+		} else if scenarioResult.GetFailed() {
 			specResult.IsFailed = true
 			specResult.ScenarioFailedCount++
 		}
@@ -50,7 +54,7 @@ func (specResult *SpecResult) AddScenarioResults(scenarioResults []Result) {
 }
 
 func (specResult *SpecResult) AddTableDrivenScenarioResult(r *ScenarioResult, t *gauge_messages.ProtoTable, scenarioRowIndex int, specRowIndex int, specTableDriven bool) {
-	if r.GetFailed() {
+	if r.GetFailed() && !r.Quarantined {
 		specResult.IsFailed = true
 		// ScenarioFailedCount is aggregated once per distinct scenario by the
 		// caller (executeScenarioTableDrivenScenarios), not per data-table row,
@@ -78,11 +82,14 @@ func (specResult *SpecResult) AddTableRelatedScenarioResult(scenarioResults [][]
 
 	for scenarioIndex := 0; scenarioIndex < numberOfScenarios; scenarioIndex++ {
 		scenarioFailed := false
+		scenarioQuarantined := false
 		for _, eachRow := range scenarioResults {
 			protoScenario := eachRow[scenarioIndex].Item().(*gauge_messages.ProtoScenario)
 			result := eachRow[scenarioIndex].(*ScenarioResult)
 			specResult.AddExecTime(protoScenario.GetExecutionTime())
-			if protoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED {
+			if result.GetQuarantinedFailure() {
+				scenarioQuarantined = true
+			} else if protoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED {
 				scenarioFailed = true
 				specResult.FailedDataTableRows = append(specResult.FailedDataTableRows, int32(index))
 			}
@@ -104,12 +111,19 @@ func (specResult *SpecResult) AddTableRelatedScenarioResult(scenarioResults [][]
 		if scenarioFailed {
 			specResult.ScenarioFailedCount++
 			specResult.IsFailed = true
+		} else if scenarioQuarantined {
+			specResult.ScenarioQuarantinedCount++
 		}
 	}
 	specResult.ProtoSpec.IsTableDriven = true
 	specResult.ScenarioCount += numberOfScenarios
 }
 
+func isQuarantinedFailure(r Result) bool {
+	s, ok := r.(*ScenarioResult)
+	return ok && s.GetQuarantinedFailure()
+}
+
 func (specResult *SpecResult) AddExecTime(execTime int64) {
 	specResult.ExecutionTime += execTime
 }
@@ -98,3 +98,16 @@ func (s *MySuite) TestAddTableDrivenScenarioResultDoesNotBumpFailedCountPerRow(c
 	c.Assert(specResult.ScenarioFailedCount, gc.Equals, 0)
 	c.Assert(len(specResult.ProtoSpec.Items), gc.Equals, 2)
 }
+
+func (s *MySuite) TestAddScenarioResultsWithQuarantinedFailure(c *gc.C) {
+	specResult := SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}}
+	quarantined := &ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED}, Quarantined: true}
+	passed := &ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_PASSED}, Quarantined: true}
+
+	specResult.AddScenarioResults([]Result{quarantined, passed})
+
+	c.Assert(specResult.GetFailed(), gc.Equals, false)
+	c.Assert(specResult.ScenarioCount, gc.Equals, 2)
+	c.Assert(specResult.ScenarioFailedCount, gc.Equals, 0)
+	c.Assert(specResult.ScenarioQuarantinedCount, gc.Equals, 1)
+}
@@ -0,0 +1,279 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/getgauge/common"
+	m "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+// Resume if true skips the specs completed in the last run, and merges their results with the results of this run.
+var Resume bool
+
+const checkpointFile = "checkpoint"
+
+// resumedResults holds the results of the specs completed in the last run, when resuming it.
+var resumedResults []*result.SpecResult
+
+// checkpointEntry is a line of the checkpoint file, holding the result of a completed spec.
+type checkpointEntry struct {
+	SpecResult               []byte `json:"specResult"`
+	ScenarioQuarantinedCount int    `json:"scenarioQuarantinedCount,omitempty"`
+}
+
+// ListenSpecEndAndCheckpoint listens to the spec end events and appends the result of every executed spec to the checkpoint file,
+// so that the execution can be resumed if it is interrupted. The checkpoint of the last run is discarded, unless resuming it.
+// The checkpoint is removed once the suite ends, unless the execution was cut short by an interrupt or the time budget.
+func ListenSpecEndAndCheckpoint(wg *sync.WaitGroup) {
+	if !Resume {
+		removeCheckpoint()
+	}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SpecEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			switch e.Topic {
+			case event.SpecEnd:
+				if res := e.Result.(*result.SpecResult); !res.Skipped {
+					writeCheckpoint(res)
+				}
+			case event.SuiteEnd:
+				if scenarioHaltReason() == notHalted {
+					removeCheckpoint()
+				}
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func removeCheckpoint() {
+	if err := os.Remove(checkpointPath()); err != nil && !os.IsNotExist(err) {
+		logger.Errorf(true, "Failed to remove %s. Reason: %s", checkpointPath(), err.Error())
+	}
+}
+
+func checkpointPath() string {
+	return filepath.Join(config.ProjectRoot, common.DotGauge, checkpointFile)
+}
+
+func writeCheckpoint(res *result.SpecResult) {
+	dotGaugeDir := filepath.Join(config.ProjectRoot, common.DotGauge)
+	if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, err.Error())
+		return
+	}
+	entry, err := newCheckpointEntry(res)
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal spec execution result, skipping checkpoint. %s", err.Error())
+		return
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal spec execution result, skipping checkpoint. %s", err.Error())
+		return
+	}
+	f, err := os.OpenFile(checkpointPath(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, common.NewFilePermissions)
+	if err != nil {
+		logger.Errorf(true, "Failed to open %s. Reason: %s", checkpointPath(), err.Error())
+		return
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err = f.Write(append(line, '\n')); err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", checkpointPath(), err.Error())
+	}
+}
+
+// readCheckpoint reads the results of the specs completed in the last run.
+// Reading stops at the first incomplete entry, which is written when the execution is killed while saving it.
+func readCheckpoint() ([]*result.SpecResult, error) {
+	f, err := os.Open(checkpointPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	var results []*result.SpecResult
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		entry := &checkpointEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			logger.Debugf(true, "Ignoring incomplete checkpoint entry. %s", err.Error())
+			break
+		}
+		res, err := entry.specResult()
+		if err != nil {
+			logger.Debugf(true, "Ignoring incomplete checkpoint entry. %s", err.Error())
+			break
+		}
+		results = append(results, res)
+	}
+	return results, scanner.Err()
+}
+
+func newCheckpointEntry(res *result.SpecResult) (*checkpointEntry, error) {
+	r, err := proto.Marshal(gauge.ConvertToProtoSpecResult(res))
+	if err != nil {
+		return nil, err
+	}
+	return &checkpointEntry{SpecResult: r, ScenarioQuarantinedCount: res.ScenarioQuarantinedCount}, nil
+}
+
+func (entry *checkpointEntry) specResult() (*result.SpecResult, error) {
+	r := &m.ProtoSpecResult{}
+	if err := proto.Unmarshal(entry.SpecResult, r); err != nil {
+		return nil, err
+	}
+	return &result.SpecResult{
+		ProtoSpec:                r.GetProtoSpec(),
+		ScenarioCount:            int(r.GetScenarioCount()),
+		ScenarioFailedCount:      int(r.GetScenarioFailedCount()),
+		IsFailed:                 r.GetFailed(),
+		FailedDataTableRows:      r.GetFailedDataTableRows(),
+		ExecutionTime:            r.GetExecutionTime(),
+		Skipped:                  r.GetSkipped(),
+		ScenarioSkippedCount:     int(r.GetScenarioSkippedCount()),
+		ScenarioQuarantinedCount: entry.ScenarioQuarantinedCount,
+		Errors:                   r.GetErrors(),
+	}, nil
+}
+
+// resumeExecution reads the results of the specs completed in the last run, and removes their scenarios from the specs to be executed.
+func resumeExecution(specs *gauge.SpecCollection, errMap *gauge.BuildErrors) *gauge.SpecCollection {
+	results, err := readCheckpoint()
+	if err != nil {
+		logger.Fatalf(true, "Failed to read %s. Reason: %s", checkpointPath(), err.Error())
+	}
+	for _, res := range results {
+		removeSkippedScenarios(res)
+	}
+	resumedResults = results
+	if len(results) == 0 {
+		logger.Infof(true, "No completed specs found to resume from, executing all specs.")
+		return specs
+	}
+	completed := completedScenarios(results)
+	var pending []*gauge.Specification
+	for _, spec := range specs.Specs() {
+		var scenarios []*gauge.Scenario
+		for _, scn := range spec.Scenarios {
+			if !completed[scenarioKey(spec.FileName, specRowIndex(scn), scn.Span.Start, scenarioRowIndex(scn))] {
+				scenarios = append(scenarios, scn)
+			}
+		}
+		switch {
+		case len(scenarios) == len(spec.Scenarios):
+			pending = append(pending, spec)
+		case len(scenarios) > 0:
+			pending = append(pending, specWithScenarios(spec, scenarios, errMap))
+		}
+	}
+	logger.Infof(true, "Resuming execution, skipping the scenarios completed in the last run.")
+	return gauge.NewSpecCollection(pending, false)
+}
+
+// removeSkippedScenarios removes the scenarios skipped in the last run from its spec result, as they are to be executed again,
+// like the scenarios left out when it was interrupted or ran out of its time budget.
+func removeSkippedScenarios(res *result.SpecResult) {
+	var items []*m.ProtoItem
+	for _, item := range res.ProtoSpec.GetItems() {
+		if isSkippedScenario(item) {
+			res.ScenarioCount--
+			res.ScenarioSkippedCount--
+			continue
+		}
+		items = append(items, item)
+	}
+	res.ProtoSpec.Items = items
+}
+
+func isSkippedScenario(item *m.ProtoItem) bool {
+	switch item.GetItemType() {
+	case m.ProtoItem_Scenario:
+		return item.GetScenario().GetExecutionStatus() == m.ExecutionStatus_SKIPPED
+	case m.ProtoItem_TableDrivenScenario:
+		return item.GetTableDrivenScenario().GetScenario().GetExecutionStatus() == m.ExecutionStatus_SKIPPED
+	}
+	return false
+}
+
+func completedScenarios(results []*result.SpecResult) map[string]bool {
+	completed := make(map[string]bool)
+	for _, res := range results {
+		for _, item := range res.ProtoSpec.GetItems() {
+			if isSkippedScenario(item) {
+				continue
+			}
+			switch item.GetItemType() {
+			case m.ProtoItem_Scenario:
+				completed[scenarioKey(res.ProtoSpec.GetFileName(), -1, int(item.GetScenario().GetSpan().GetStart()), -1)] = true
+			case m.ProtoItem_TableDrivenScenario:
+				tds := item.GetTableDrivenScenario()
+				specRow, scnRow := -1, -1
+				if tds.GetIsSpecTableDriven() {
+					specRow = int(tds.GetTableRowIndex())
+				}
+				if tds.GetIsScenarioTableDriven() {
+					scnRow = int(tds.GetScenarioTableRowIndex())
+				}
+				completed[scenarioKey(res.ProtoSpec.GetFileName(), specRow, int(tds.GetScenario().GetSpan().GetStart()), scnRow)] = true
+			}
+		}
+	}
+	return completed
+}
+
+func scenarioKey(fileName string, specRow, line, scenarioRow int) string {
+	return fmt.Sprintf("%s:%d:%d:%d", fileName, specRow, line, scenarioRow)
+}
+
+func specRowIndex(scn *gauge.Scenario) int {
+	if scn.SpecDataTableRow.IsInitialized() {
+		return scn.SpecDataTableRowIndex
+	}
+	return -1
+}
+
+func scenarioRowIndex(scn *gauge.Scenario) int {
+	if scn.ScenarioDataTableRow.IsInitialized() {
+		return scn.ScenarioDataTableRowIndex
+	}
+	return -1
+}
+
+// withResumedResults adds the results of the specs completed in the resumed run to the suite result, to be merged with the results of this run.
+func withResumedResults(r *result.SuiteResult) *result.SuiteResult {
+	r.AddSpecResults(resumedResults)
+	return r
+}
@@ -0,0 +1,169 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	m "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func completedSpecResult(fileName string, failed bool, lines ...int64) *result.SpecResult {
+	res := &result.SpecResult{ProtoSpec: &m.ProtoSpec{FileName: fileName}, IsFailed: failed, ScenarioCount: len(lines)}
+	for _, l := range lines {
+		res.ProtoSpec.Items = append(res.ProtoSpec.Items, &m.ProtoItem{ItemType: m.ProtoItem_Scenario, Scenario: &m.ProtoScenario{Span: &m.Span{Start: l}}})
+	}
+	return res
+}
+
+func specWithScenarioLines(fileName string, lines ...int) *gauge.Specification {
+	spec := &gauge.Specification{FileName: fileName, Heading: &gauge.Heading{Value: fileName}}
+	for _, l := range lines {
+		scn := &gauge.Scenario{Heading: &gauge.Heading{Value: "Scenario"}, Span: &gauge.Span{Start: l}}
+		spec.Scenarios = append(spec.Scenarios, scn)
+		spec.Items = append(spec.Items, scn)
+	}
+	return spec
+}
+
+func TestCheckpointIsReadBack(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	writeCheckpoint(completedSpecResult("a.spec", false, 3, 7))
+	failed := completedSpecResult("b.spec", true, 4)
+	failed.ScenarioQuarantinedCount = 1
+	writeCheckpoint(failed)
+
+	got, err := readCheckpoint()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 spec results. Got %d", len(got))
+	}
+	if got[0].ProtoSpec.GetFileName() != "a.spec" || got[0].ScenarioCount != 2 || got[0].IsFailed {
+		t.Errorf("Unexpected result of a.spec: %v", got[0])
+	}
+	if got[1].ProtoSpec.GetFileName() != "b.spec" || !got[1].IsFailed || got[1].ScenarioQuarantinedCount != 1 {
+		t.Errorf("Unexpected result of b.spec: %v", got[1])
+	}
+}
+
+func TestIncompleteCheckpointEntryIsIgnored(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	writeCheckpoint(completedSpecResult("a.spec", false, 3))
+	f, err := os.OpenFile(checkpointPath(), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`{"specResult":"Cg`)
+	_ = f.Close()
+
+	got, err := readCheckpoint()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 1 || got[0].ProtoSpec.GetFileName() != "a.spec" {
+		t.Errorf("Expected only the complete entry to be read. Got %v", got)
+	}
+}
+
+func TestResumeExecutionSkipsCompletedScenarios(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	writeCheckpoint(completedSpecResult("a.spec", false, 3, 7))
+	writeCheckpoint(completedSpecResult("b.spec", false, 4))
+	defer func() {
+		resumedResults = nil
+	}()
+	specs := gauge.NewSpecCollection([]*gauge.Specification{
+		specWithScenarioLines("a.spec", 3, 7),
+		specWithScenarioLines("b.spec", 4, 9),
+		specWithScenarioLines("c.spec", 5),
+	}, false)
+
+	pending := resumeExecution(specs, gauge.NewBuildErrors())
+
+	var got []string
+	for _, spec := range pending.Specs() {
+		for _, scn := range spec.Scenarios {
+			got = append(got, scenarioRef(spec.FileName, scn))
+		}
+	}
+	want := []string{"b.spec:9", "c.spec:5"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+	if len(resumedResults) != 2 {
+		t.Errorf("Expected results of 2 completed specs to be resumed. Got %d", len(resumedResults))
+	}
+}
+
+func TestResumeExecutionRunsScenariosSkippedInLastRun(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	interrupted := completedSpecResult("a.spec", false, 3, 7)
+	interrupted.ProtoSpec.Items[1].Scenario.ExecutionStatus = m.ExecutionStatus_SKIPPED
+	interrupted.ScenarioSkippedCount = 1
+	writeCheckpoint(interrupted)
+	defer func() {
+		resumedResults = nil
+	}()
+	specs := gauge.NewSpecCollection([]*gauge.Specification{specWithScenarioLines("a.spec", 3, 7)}, false)
+
+	pending := resumeExecution(specs, gauge.NewBuildErrors())
+
+	if pending.Size() != 1 || len(pending.Specs()[0].Scenarios) != 1 || pending.Specs()[0].Scenarios[0].Span.Start != 7 {
+		t.Errorf("Expected the skipped scenario to be executed again. Got %v", pending.Specs())
+	}
+	if len(resumedResults) != 1 || len(resumedResults[0].ProtoSpec.Items) != 1 || resumedResults[0].ScenarioCount != 1 || resumedResults[0].ScenarioSkippedCount != 0 {
+		t.Errorf("Expected the skipped scenario to be removed from the resumed results. Got %v", resumedResults)
+	}
+}
+
+func TestCheckpointIsRemovedWhenSuiteEnds(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+	Resume = true
+	defer func() {
+		Resume = false
+		event.InitRegistry()
+	}()
+	writeCheckpoint(completedSpecResult("a.spec", false, 3))
+	event.InitRegistry()
+	wg := &sync.WaitGroup{}
+	ListenSpecEndAndCheckpoint(wg)
+
+	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, result.NewSuiteResult("", time.Now()), 0, &m.ExecutionInfo{}))
+	wg.Wait()
+
+	if _, err := os.Stat(checkpointPath()); !os.IsNotExist(err) {
+		t.Errorf("Expected checkpoint to be removed after the suite ends. Got %v", err)
+	}
+}
+
+func TestResumedResultsAreMergedWithNewResults(t *testing.T) {
+	resumedResults = []*result.SpecResult{completedSpecResult("a.spec", true, 3)}
+	defer func() {
+		resumedResults = nil
+	}()
+	r := result.NewSuiteResult("", time.Now())
+	r.AddSpecResult(completedSpecResult("a.spec", false, 7))
+	r.AddSpecResult(completedSpecResult("b.spec", false, 4))
+
+	merged := mergeDataTableSpecResults(withResumedResults(r))
+
+	if !merged.IsFailed || merged.SpecsFailedCount != 1 || len(merged.SpecResults) != 2 {
+		t.Errorf("Expected a.spec results to be merged and failed. Got %v", merged)
+	}
+}
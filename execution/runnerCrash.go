@@ -0,0 +1,99 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sync/atomic"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/runner"
+)
+
+// MaxRunnerRestarts is the number of times runners are restarted in an execution, after crashing or timing out.
+var MaxRunnerRestarts int
+
+var runnerRestartsCount atomic.Int64
+
+func resetRunnerRestartsCount() {
+	runnerRestartsCount.Store(0)
+}
+
+// recoverFromCrash fails the scenario if the runner crashed while executing it, and restarts the runner
+// so that the next scenarios are executed by a new one. The reason for the crash is recorded as the error
+// of the step, or the hook, which was being executed when the runner crashed.
+func recoverFromCrash(r runner.Runner, scenarioResult *result.ScenarioResult) {
+	rr, ok := asRestartable(r)
+	if !ok {
+		return
+	}
+	c, ok := rr.get().(runner.CrashReporter)
+	if !ok || !c.Crashed() {
+		return
+	}
+	reason := c.ExitReason()
+	logger.Errorf(true, "%s", reason)
+	recordCrash(scenarioResult, reason)
+	restartRunner(rr)
+}
+
+// restartRunner restarts the runner, unless the runners are restarted MaxRunnerRestarts times in the execution already.
+func restartRunner(rr *restartableRunner) {
+	if runnerRestartsCount.Add(1) > int64(MaxRunnerRestarts) {
+		logger.Errorf(true, "Not restarting the runner as the limit of %d restarts is reached.", MaxRunnerRestarts)
+		return
+	}
+	if err := rr.restart(); err != nil {
+		logger.Errorf(true, "Failed to restart runner. %s", err.Error())
+	}
+}
+
+func recordCrash(scenarioResult *result.ScenarioResult, reason string) {
+	scenario := scenarioResult.ProtoScenario
+	if res := findStepResult(scenario.GetScenarioItems(), isFailedStep); res != nil {
+		res.ExecutionResult.ErrorMessage = reason
+		scenarioResult.SetFailure()
+		return
+	}
+	for _, hook := range []*gauge_messages.ProtoHookFailure{scenario.GetPreHookFailure(), scenario.GetPostHookFailure()} {
+		if hook != nil {
+			hook.ErrorMessage = reason
+			scenarioResult.SetFailure()
+			return
+		}
+	}
+	if res := findStepResult(scenario.GetScenarioItems(), isNotExecutedStep); res != nil {
+		res.ExecutionResult = &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: reason}
+	}
+	scenarioResult.SetFailure()
+}
+
+// findStepResult gives the result of the first step, including the steps of concepts, which matches.
+func findStepResult(items []*gauge_messages.ProtoItem, matches func(*gauge_messages.ProtoStepExecutionResult) bool) *gauge_messages.ProtoStepExecutionResult {
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Step:
+			if res := item.GetStep().GetStepExecutionResult(); res != nil && matches(res) {
+				return res
+			}
+		case gauge_messages.ProtoItem_Concept:
+			if res := findStepResult(item.GetConcept().GetSteps(), matches); res != nil {
+				return res
+			}
+		}
+	}
+	return nil
+}
+
+func isFailedStep(res *gauge_messages.ProtoStepExecutionResult) bool {
+	return res.GetExecutionResult().GetFailed()
+}
+
+func isNotExecutedStep(res *gauge_messages.ProtoStepExecutionResult) bool {
+	return res.GetExecutionResult() == nil && !res.GetSkipped()
+}
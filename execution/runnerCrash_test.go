@@ -0,0 +1,161 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/runner"
+)
+
+type crashedRunner struct {
+	mockRunner
+}
+
+func (r *crashedRunner) Crashed() bool {
+	return true
+}
+
+func (r *crashedRunner) ExitReason() string {
+	return "Runner with pid 42 quit unexpectedly(exit status 1)"
+}
+
+var specWithStep = &gauge.Specification{
+	Heading:  &gauge.Heading{Value: "Example Spec"},
+	FileName: "example.spec",
+	Tags:     &gauge.Tags{},
+	Scenarios: []*gauge.Scenario{
+		{Heading: &gauge.Heading{Value: "Example Scenario"}, Items: []gauge.Item{&gauge.Step{Value: "a step", LineText: "a step"}}, Tags: &gauge.Tags{}, Span: &gauge.Span{}},
+	},
+}
+
+func crashedRunnerExecutor() (*specExecutor, *restartableRunner) {
+	r := newRestartableRunner(&crashedRunner{}, &manifest.Manifest{Language: "java"}, 0)
+	se := newSpecExecutor(specWithStep, r, nil, gauge.NewBuildErrors(), 0)
+	se.specResult = gauge.NewSpecResult(specWithStep)
+	se.scenarioExecutor = &mockExecutor{
+		executeFunc: func(i gauge.Item, r result.Result) {},
+	}
+	MaxRetriesCount = 1
+	resetRunnerRestartsCount()
+	return se, r
+}
+
+func TestExecuteScenarioShouldFailScenarioAndRestartRunnerWhenRunnerCrashed(t *testing.T) {
+	MaxRunnerRestarts = 1
+	defer func() {
+		MaxRunnerRestarts = 0
+		startNewRunner = runner.Start
+	}()
+	var initialized []gauge_messages.Message_MessageType
+	restarted := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		initialized = append(initialized, m.MessageType)
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+	startNewRunner = func(*manifest.Manifest, int, chan bool, bool) (runner.Runner, error) {
+		return restarted, nil
+	}
+	se, r := crashedRunnerExecutor()
+
+	sceResult, _ := se.executeScenario(specWithStep.Scenarios[0])
+
+	if !sceResult.GetFailed() {
+		t.Errorf("Expected scenario to be failed, got %v", sceResult.ProtoScenario.GetExecutionStatus())
+	}
+	if got := sceResult.ProtoScenario.GetScenarioItems()[0].GetStep().GetStepExecutionResult().GetExecutionResult().GetErrorMessage(); got != "Runner with pid 42 quit unexpectedly(exit status 1)" {
+		t.Errorf("Expected runner exit reason as the error of the step, got %q", got)
+	}
+	if sceResult.ProtoScenario.GetPostHookFailure() != nil {
+		t.Errorf("Expected runner exit reason not to be recorded as a hook failure")
+	}
+	if r.get() != restarted {
+		t.Errorf("Expected runner to be restarted")
+	}
+	if len(initialized) != 3 || initialized[1] != gauge_messages.Message_SpecDataStoreInit {
+		t.Errorf("Expected data stores to be initialized after restart, got %v", initialized)
+	}
+}
+
+func TestExecuteScenarioShouldNotRestartRunnerMoreThanMaxRunnerRestarts(t *testing.T) {
+	MaxRunnerRestarts = 0
+	defer func() {
+		startNewRunner = runner.Start
+	}()
+	startNewRunner = func(*manifest.Manifest, int, chan bool, bool) (runner.Runner, error) {
+		t.Errorf("Expected runner not to be restarted")
+		return nil, nil
+	}
+	se, _ := crashedRunnerExecutor()
+
+	sceResult, _ := se.executeScenario(specWithStep.Scenarios[0])
+
+	if !sceResult.GetFailed() {
+		t.Errorf("Expected scenario to be failed, got %v", sceResult.ProtoScenario.GetExecutionStatus())
+	}
+}
+
+func TestRecordCrashShouldSetTheReasonAsTheErrorOfTheFailedStep(t *testing.T) {
+	failed := &gauge_messages.ProtoStepExecutionResult{ExecutionResult: &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "connection reset"}}
+	notExecuted := &gauge_messages.ProtoStepExecutionResult{}
+	sceResult := &result.ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{ScenarioItems: []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Step, Step: &gauge_messages.ProtoStep{StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{ExecutionResult: &gauge_messages.ProtoExecutionResult{}}}},
+		{ItemType: gauge_messages.ProtoItem_Concept, Concept: &gauge_messages.ProtoConcept{Steps: []*gauge_messages.ProtoItem{
+			{ItemType: gauge_messages.ProtoItem_Step, Step: &gauge_messages.ProtoStep{StepExecutionResult: failed}},
+		}}},
+		{ItemType: gauge_messages.ProtoItem_Step, Step: &gauge_messages.ProtoStep{StepExecutionResult: notExecuted}},
+	}}}
+
+	recordCrash(sceResult, "Runner with pid 42 quit unexpectedly(exit status 1)")
+
+	if got := failed.GetExecutionResult().GetErrorMessage(); got != "Runner with pid 42 quit unexpectedly(exit status 1)" {
+		t.Errorf("Expected runner exit reason as the error of the failed step, got %q", got)
+	}
+	if notExecuted.GetExecutionResult() != nil {
+		t.Errorf("Expected the steps after the failed step to be left as they are, got %v", notExecuted.GetExecutionResult())
+	}
+	if !sceResult.GetFailed() {
+		t.Errorf("Expected scenario to be failed")
+	}
+}
+
+func TestRestartExecutesHooksOfSuiteAndSpecInExecutionAgain(t *testing.T) {
+	defer func() {
+		startNewRunner = runner.Start
+	}()
+	var executed []gauge_messages.Message_MessageType
+	record := func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		executed = append(executed, m.MessageType)
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	startNewRunner = func(*manifest.Manifest, int, chan bool, bool) (runner.Runner, error) {
+		return &mockRunner{ExecuteAndGetStatusFunc: record}, nil
+	}
+	r := newRestartableRunner(&mockRunner{ExecuteAndGetStatusFunc: record}, &manifest.Manifest{Language: "java"}, 0)
+	r.ExecuteAndGetStatus(&gauge_messages.Message{MessageType: gauge_messages.Message_ExecutionStarting})
+	r.ExecuteAndGetStatus(&gauge_messages.Message{MessageType: gauge_messages.Message_SpecExecutionStarting})
+	executed = nil
+
+	if err := r.restart(); err != nil {
+		t.Fatal(err)
+	}
+	if len(executed) != 5 || executed[3] != gauge_messages.Message_ExecutionStarting || executed[4] != gauge_messages.Message_SpecExecutionStarting {
+		t.Errorf("Expected before suite and before spec hooks to be executed after the data stores are initialized, got %v", executed)
+	}
+
+	r.ExecuteAndGetStatus(&gauge_messages.Message{MessageType: gauge_messages.Message_SpecExecutionEnding})
+	executed = nil
+	if err := r.restart(); err != nil {
+		t.Fatal(err)
+	}
+	if len(executed) != 4 || executed[3] != gauge_messages.Message_ExecutionStarting {
+		t.Errorf("Expected only the before suite hooks to be executed once the spec is done, got %v", executed)
+	}
+}
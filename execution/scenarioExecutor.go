@@ -8,6 +8,7 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	"errors"
 
@@ -29,6 +30,8 @@ type scenarioExecutor struct {
 	stream               int
 	contexts             []*gauge.Step
 	teardowns            []*gauge.Step
+	timeouts             timeouts
+	deadline             time.Time
 }
 
 func newScenarioExecutor(r runner.Runner, ph plugin.Handler, ei *gauge_messages.ExecutionInfo, errMap *gauge.BuildErrors, contexts []*gauge.Step, teardowns []*gauge.Step, stream int) *scenarioExecutor {
@@ -52,7 +55,7 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
 		return
 	}
-	if scenario.SpecDataTableRow.IsInitialized() && !shouldExecuteForRow(scenario.SpecDataTableRowIndex) {
+	if !shouldExecuteForRows(scenario) {
 		e.errMap.ScenarioErrs[scenario] = append([]error{errors.New("skipped Reason: Doesn't satisfy --table-rows flag condition")}, e.errMap.ScenarioErrs[scenario]...)
 		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
 		return
@@ -65,6 +68,7 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 	}
 	event.Notify(event.NewExecutionEvent(event.ScenarioStart, scenario, scenarioResult, e.stream, e.currentExecutionInfo))
 	defer event.Notify(event.NewExecutionEvent(event.ScenarioEnd, scenario, scenarioResult, e.stream, e.currentExecutionInfo))
+	e.startTimer()
 
 	res := e.initScenarioDataStore()
 	if res.GetFailed() {
@@ -84,6 +88,8 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 			}
 		}
 		// teardowns are not appended to previous call to executeSteps to ensure they are run irrespective of context/step failure
+		// and are bounded only by the step timeout, so that they run even if the scenario has timed out.
+		e.deadline = time.Time{}
 		e.executeSteps(e.teardowns, scenarioResult.ProtoScenario.GetTearDownSteps(), scenarioResult)
 	}
 
@@ -96,6 +102,31 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 	scenarioResult.UpdateExecutionTime()
 }
 
+func (e *scenarioExecutor) startTimer() {
+	e.timeouts = getTimeouts(e.currentExecutionInfo.GetCurrentSpec().GetTags(), e.currentExecutionInfo.GetCurrentScenario().GetTags())
+	e.deadline = time.Time{}
+	if e.timeouts.scenario > 0 {
+		e.deadline = time.Now().Add(e.timeouts.scenario)
+	}
+}
+
+// stepTimeout gives the time the next step can take along with the error message to use when it runs out.
+// The limit is negative if the scenario has already run out of time.
+func (e *scenarioExecutor) stepTimeout() (time.Duration, string) {
+	timeout, message := e.timeouts.step, fmt.Sprintf("Step timed out after %s", e.timeouts.step)
+	if e.deadline.IsZero() {
+		return timeout, message
+	}
+	remaining := time.Until(e.deadline)
+	if timeout == 0 || remaining < timeout {
+		timeout, message = remaining, fmt.Sprintf("Scenario timed out after %s", e.timeouts.scenario)
+		if timeout <= 0 {
+			timeout = -1
+		}
+	}
+	return timeout, message
+}
+
 func (e *scenarioExecutor) initScenarioDataStore() *gauge_messages.ProtoExecutionResult {
 	msg := &gauge_messages.Message{MessageType: gauge_messages.Message_ScenarioDataStoreInit,
 		ScenarioDataStoreInitRequest: &gauge_messages.ScenarioDataStoreInitRequest{Stream: int32(e.stream)}}
@@ -235,7 +266,8 @@ func (e *scenarioExecutor) executeStep(step *gauge.Step, protoItem *gauge_messag
 		recoverable = res.GetRecoverable()
 
 	} else if protoItem.GetItemType() == gauge_messages.ProtoItem_Step {
-		se := &stepExecutor{runner: e.runner, pluginHandler: e.pluginHandler, currentExecutionInfo: e.currentExecutionInfo, stream: e.stream}
+		timeout, message := e.stepTimeout()
+		se := &stepExecutor{runner: e.runner, pluginHandler: e.pluginHandler, currentExecutionInfo: e.currentExecutionInfo, stream: e.stream, timeout: timeout, timeoutMessage: message}
 		res := se.executeStep(step, protoItem.GetStep())
 		protoItem.GetStep().StepExecutionResult = res.ProtoStepExecResult()
 		if res.ProtoStepExecResult().ExecutionResult.GetSkipScenario() {
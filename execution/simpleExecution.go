@@ -26,8 +26,17 @@ import (
 var ExecuteTags = ""
 var tableRowsIndexes []int
 
-// SetTableRows is used to limit data driven execution to specific rows
+// tableRowsFilter selects the data table rows by their column values, when the table rows are given as a filter.
+var tableRowsFilter *gauge.TableRowFilter
+
+// SetTableRows is used to limit data driven execution to specific rows, given as row numbers or as a filter on column values
 func SetTableRows(tableRows string) {
+	tableRowsIndexes, tableRowsFilter = nil, nil
+	if gauge.IsTableRowFilter(tableRows) {
+		// the filter is validated along with the specs.
+		tableRowsFilter, _ = gauge.ParseTableRowFilter(tableRows)
+		return
+	}
 	tableRowsIndexes = getDataTableRows(tableRows)
 }
 
@@ -55,10 +64,14 @@ func newSimpleExecution(executionInfo *executionInfo, combineDataTableSpecs, ski
 		ExecutionArgs:            gauge.ConvertToProtoExecutionArg(ExecutionArgs),
 	}
 
+	r := executionInfo.runner
+	if isRestartable(executionInfo, skipSuiteEvents) {
+		r = newRestartableRunner(r, executionInfo.manifest, executionInfo.stream)
+	}
 	return &simpleExecution{
 		manifest:             executionInfo.manifest,
 		specCollection:       executionInfo.specs,
-		runner:               executionInfo.runner,
+		runner:               r,
 		pluginHandler:        executionInfo.pluginHandler,
 		errMaps:              executionInfo.errMaps,
 		stream:               executionInfo.stream,
@@ -67,7 +80,20 @@ func newSimpleExecution(executionInfo *executionInfo, combineDataTableSpecs, ski
 	}
 }
 
+// isRestartable tells if the runner is owned by a single stream, and can hence be restarted when it stops responding.
+func isRestartable(executionInfo *executionInfo, skipSuiteEvents bool) bool {
+	if executionInfo.manifest == nil || executionInfo.runner == nil || skipSuiteEvents {
+		return false
+	}
+	if _, ok := asRestartable(executionInfo.runner); ok {
+		return false
+	}
+	_, ok := executionInfo.runner.(*runner.MultithreadedRunner)
+	return !ok
+}
+
 func (e *simpleExecution) run() *result.SuiteResult {
+	expectSpecs(e.specCollection.Specs())
 	e.start()
 	e.execute()
 	e.finish()
@@ -107,7 +133,7 @@ func (e *simpleExecution) start() {
 }
 
 func (e *simpleExecution) finish() {
-	e.suiteResult = mergeDataTableSpecResults(e.suiteResult)
+	e.suiteResult = mergeDataTableSpecResults(withResumedResults(e.suiteResult))
 	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, e.suiteResult, 0, &gauge_messages.ExecutionInfo{}))
 	e.notifyExecutionResult()
 	e.stopAllPlugins()
@@ -123,39 +149,58 @@ func (e *simpleExecution) stopAllPlugins() {
 func (e *simpleExecution) executeSpecs(sc *gauge.SpecCollection) (results []*result.SpecResult) {
 	for sc.HasNext() {
 		specs := sc.Next()
-		var preHookFailures, postHookFailures []*gauge_messages.ProtoHookFailure
-		var specResults []*result.SpecResult
-		var before, after = true, false
-		for i, spec := range specs {
-			if i == len(specs)-1 {
-				after = true
-			}
-			res := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream).execute(before, preHookFailures == nil, after)
-			before = false
-			specResults = append(specResults, res)
-			preHookFailures = append(preHookFailures, res.GetPreHook()...)
-			postHookFailures = append(postHookFailures, res.GetPostHook()...)
-			res.ProtoSpec.PreHookFailures, res.ProtoSpec.PostHookFailures = []*gauge_messages.ProtoHookFailure{}, []*gauge_messages.ProtoHookFailure{}
+		if specs == nil {
+			break
+		}
+		res := e.executeSpecGroup(specs)
+		recordSpecOutcome(specs, res)
+		results = append(results, res...)
+		sc.Done(specs)
+	}
+	return results
+}
+
+// executeSpecGroup executes a group of specs, which are the data table rows of the same spec file.
+func (e *simpleExecution) executeSpecGroup(specs []*gauge.Specification) (results []*result.SpecResult) {
+	if cause := haltReason(); cause != notHalted {
+		// specs of a group are the data table rows of the same spec file, which is reported once.
+		return append(results, newSpecExecutor(specs[0], e.runner, e.pluginHandler, e.errMaps, e.stream).skipFor(cause))
+	}
+	if reason := prerequisiteFailure(specs[0]); reason != "" {
+		return append(results, newSpecExecutor(specs[0], e.runner, e.pluginHandler, e.errMaps, e.stream).skip(reason))
+	}
+	var preHookFailures, postHookFailures []*gauge_messages.ProtoHookFailure
+	var specResults []*result.SpecResult
+	var before, after = true, false
+	for i, spec := range specs {
+		if i == len(specs)-1 {
+			after = true
+		}
+		res := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream).execute(before, preHookFailures == nil, after)
+		before = false
+		specResults = append(specResults, res)
+		preHookFailures = append(preHookFailures, res.GetPreHook()...)
+		postHookFailures = append(postHookFailures, res.GetPostHook()...)
+		res.ProtoSpec.PreHookFailures, res.ProtoSpec.PostHookFailures = []*gauge_messages.ProtoHookFailure{}, []*gauge_messages.ProtoHookFailure{}
+	}
+	for _, res := range specResults {
+		for _, preHook := range preHookFailures {
+			res.AddPreHook(&gauge_messages.ProtoHookFailure{
+				StackTrace:            preHook.StackTrace,
+				ErrorMessage:          preHook.ErrorMessage,
+				FailureScreenshotFile: preHook.FailureScreenshotFile,
+				TableRowIndex:         preHook.TableRowIndex,
+			})
 		}
-		for _, res := range specResults {
-			for _, preHook := range preHookFailures {
-				res.AddPreHook(&gauge_messages.ProtoHookFailure{
-					StackTrace:            preHook.StackTrace,
-					ErrorMessage:          preHook.ErrorMessage,
-					FailureScreenshotFile: preHook.FailureScreenshotFile,
-					TableRowIndex:         preHook.TableRowIndex,
-				})
-			}
-			for _, postHook := range postHookFailures {
-				res.AddPostHook(&gauge_messages.ProtoHookFailure{
-					StackTrace:            postHook.StackTrace,
-					ErrorMessage:          postHook.ErrorMessage,
-					FailureScreenshotFile: postHook.FailureScreenshotFile,
-					TableRowIndex:         postHook.TableRowIndex,
-				})
-			}
-			results = append(results, res)
+		for _, postHook := range postHookFailures {
+			res.AddPostHook(&gauge_messages.ProtoHookFailure{
+				StackTrace:            postHook.StackTrace,
+				ErrorMessage:          postHook.ErrorMessage,
+				FailureScreenshotFile: postHook.FailureScreenshotFile,
+				TableRowIndex:         postHook.TableRowIndex,
+			})
 		}
+		results = append(results, res)
 	}
 	return results
 }
@@ -8,7 +8,9 @@ package execution
 
 import (
 	"testing"
+	"time"
 
+	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
 
@@ -186,6 +188,49 @@ func TestExecuteSpecsShouldAddsAfterSpecHookFailureScreenshotFile(t *testing.T)
 	}
 }
 
+func TestExecuteSpecsShouldSkipRemainingSpecsWhenMaxFailuresReached(t *testing.T) {
+	MaxFailures = 1
+	failedScenariosCount.Store(1)
+	defer func() {
+		MaxFailures = 0
+		resetFailedScenariosCount()
+		event.InitRegistry()
+	}()
+	r := &mockRunner{}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		t.Errorf("Expected no message to be sent to runner, got %s", m.MessageType)
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	ei := &executionInfo{runner: r, pluginHandler: h, errMaps: gauge.NewBuildErrors()}
+	simpleExecution := newSimpleExecution(ei, false, false)
+	specs := []*gauge.Specification{{
+		FileName:  "spec-1.spec",
+		Heading:   &gauge.Heading{Value: "Spec heading"},
+		Scenarios: []*gauge.Scenario{{Heading: &gauge.Heading{Value: "Scenario"}, Span: &gauge.Span{}}},
+	}}
+
+	event.InitRegistry()
+	ch := make(chan event.ExecutionEvent, 2)
+	event.Register(ch, event.SpecStart, event.SpecEnd)
+
+	specResults := simpleExecution.executeSpecs(gauge.NewSpecCollection(specs, false))
+
+	if len(specResults) != 1 || !specResults[0].Skipped || specResults[0].ScenarioSkippedCount != 1 {
+		t.Fatalf("Expected spec to be skipped, got %v", specResults)
+	}
+	skipErrors := specResults[0].ProtoSpec.Items[0].GetScenario().GetSkipErrors()
+	if len(skipErrors) != 1 || skipErrors[0] != "Skipped Reason: Maximum number of failures (1) reached" {
+		t.Errorf("Expected max failures skip reason, got %v", skipErrors)
+	}
+	if !skippedForMaxFailures.Load() {
+		t.Errorf("Expected specs to be recorded as skipped for max failures")
+	}
+	if len(ch) != 2 || (<-ch).Topic != event.SpecStart || (<-ch).Topic != event.SpecEnd {
+		t.Errorf("Expected spec start and end events for the skipped spec")
+	}
+}
+
 func createSpecCollection() *gauge.SpecCollection {
 	var specs []*gauge.Specification
 	specs = append(specs, &gauge.Specification{
@@ -199,3 +244,25 @@ func createSpecCollection() *gauge.SpecCollection {
 	})
 	return gauge.NewSpecCollection(specs, false)
 }
+
+func TestHaltReasonIsTimeBudgetBeforeMaxFailures(t *testing.T) {
+	MaxFailures = 1
+	failedScenariosCount.Store(1)
+	TimeBudget = time.Minute
+	deadline = time.Now().Add(-time.Second)
+	defer func() {
+		MaxFailures = 0
+		TimeBudget = 0
+		startTimeBudget()
+		resetFailedScenariosCount()
+	}()
+
+	if cause := haltReason(); cause != timeBudgetHalt {
+		t.Fatalf("Expected time budget to halt the execution, got %v", cause)
+	}
+	spec := &gauge.Specification{FileName: "spec-1.spec", Heading: &gauge.Heading{Value: "Spec heading"}}
+	newSpecExecutor(spec, nil, nil, gauge.NewBuildErrors(), 0).skipFor(haltReason())
+	if skippedForMaxFailures.Load() {
+		t.Errorf("Expected specs skipped for the time budget not to be recorded as skipped for max failures")
+	}
+}
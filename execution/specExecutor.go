@@ -15,6 +15,7 @@ import (
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/quarantine"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
@@ -122,6 +123,32 @@ func (e *specExecutor) execute(executeBefore, execute, executeAfter bool) *resul
 	return e.specResult
 }
 
+// skipFor marks the spec and all its scenarios as skipped, as the execution is halted due to the given cause.
+func (e *specExecutor) skipFor(cause haltCause) *result.SpecResult {
+	if cause == maxFailuresHalt {
+		skippedForMaxFailures.Store(true)
+	}
+	return e.skip(cause.reason())
+}
+
+// skip marks the spec and all its scenarios as skipped for the given reason, without executing them.
+func (e *specExecutor) skip(reason string) *result.SpecResult {
+	e.specResult = gauge.NewSpecResult(e.specification)
+	event.Notify(event.NewExecutionEvent(event.SpecStart, e.specification, e.specResult, e.stream, e.currentExecutionInfo))
+	var results []result.Result
+	for _, scenario := range e.specification.Scenarios {
+		r := result.NewScenarioResult(gauge.NewProtoScenario(scenario))
+		r.SetSkippedScenario()
+		r.ProtoScenario.SkipErrors = []string{reason}
+		results = append(results, r)
+	}
+	e.specResult.AddScenarioResults(results)
+	e.specResult.ScenarioSkippedCount = len(results)
+	e.specResult.SetSkipped(true)
+	event.Notify(event.NewExecutionEvent(event.SpecEnd, e.specification, e.specResult, e.stream, e.currentExecutionInfo))
+	return e.specResult
+}
+
 func (e *specExecutor) executeTableRelatedScenarios(scenarios []*gauge.Scenario) error {
 	if len(scenarios) > 0 {
 		index := e.specification.Scenarios[0].SpecDataTableRowIndex
@@ -161,6 +188,7 @@ func (e *specExecutor) executeSpec() error {
 func (e *specExecutor) executeScenarioTableDrivenScenarios(scenarios []*gauge.Scenario) {
 	scnMap := make(map[int]bool)
 	failedScnMap := make(map[int]bool)
+	quarantinedScnMap := make(map[int]bool)
 	for _, s := range scenarios {
 		if _, ok := scnMap[s.Span.Start]; !ok {
 			scnMap[s.Span.Start] = true
@@ -169,7 +197,9 @@ func (e *specExecutor) executeScenarioTableDrivenScenarios(scenarios []*gauge.Sc
 		if err != nil {
 			logger.Fatalf(true, "Failed to resolve Specifications : %s", err.Error())
 		}
-		if r.GetFailed() {
+		if r.GetQuarantinedFailure() {
+			quarantinedScnMap[s.Span.Start] = true
+		} else if r.GetFailed() {
 			failedScnMap[s.Span.Start] = true
 		}
 		e.specResult.AddTableDrivenScenarioResult(r, gauge.ConvertToProtoTable(s.DataTable.Table),
@@ -177,6 +207,7 @@ func (e *specExecutor) executeScenarioTableDrivenScenarios(scenarios []*gauge.Sc
 	}
 	e.specResult.ScenarioCount += len(scnMap)
 	e.specResult.ScenarioFailedCount += len(failedScnMap)
+	e.specResult.ScenarioQuarantinedCount += len(quarantinedScnMap)
 }
 
 func (e *specExecutor) initSpecDataStore() *gauge_messages.ProtoExecutionResult {
@@ -293,6 +324,9 @@ func (e *specExecutor) executeScenarios(scenarios []*gauge.Scenario) ([]result.R
 }
 
 func (e *specExecutor) executeScenario(scenario *gauge.Scenario) (*result.ScenarioResult, error) {
+	if cause := scenarioHaltReason(); cause != notHalted {
+		return e.skipScenario(scenario, cause.reason()), nil
+	}
 	var scenarioResult *result.ScenarioResult
 
 	shouldRetry := RetryOnlyTags == ""
@@ -325,24 +359,48 @@ func (e *specExecutor) executeScenario(scenario *gauge.Scenario) (*result.Scenar
 			ScenarioDataTableRow:      gauge.ConvertToProtoTable(&scenario.ScenarioDataTableRow),
 			ScenarioDataTableRowIndex: scenario.ScenarioDataTableRowIndex,
 			ScenarioDataTable:         gauge.ConvertToProtoTable(scenario.DataTable.Table),
+			Quarantined:               e.isQuarantined(scenario),
 		}
 		if err := e.addAllItemsForScenarioExecution(scenario, scenarioResult); err != nil {
 			return nil, err
 		}
 		e.scenarioExecutor.execute(scenario, scenarioResult)
 		retriesCount++
+		recoverFromCrash(e.runner, scenarioResult)
 		if scenarioResult.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
 			e.specResult.ScenarioSkippedCount++
 		}
 
-		if !shouldRetry || !scenarioResult.GetFailed() {
+		if !shouldRetry || !scenarioResult.GetFailed() || scenarioHaltReason() != notHalted {
 			break
 		}
 	}
 	scenarioResult.ProtoScenario.RetriesCount = int64(retriesCount)
+	if scenarioResult.GetQuarantinedFailure() {
+		scenarioResult.ProtoScenario.Tags = append(scenarioResult.ProtoScenario.Tags, quarantine.Tag)
+	}
+	recordScenarioResult(scenarioResult)
 	return scenarioResult, nil
 }
 
+// skipScenario marks the scenario as skipped for the given reason, without executing it.
+func (e *specExecutor) skipScenario(scenario *gauge.Scenario, reason string) *result.ScenarioResult {
+	r := &result.ScenarioResult{
+		ProtoScenario:             gauge.NewProtoScenario(scenario),
+		ScenarioDataTableRow:      gauge.ConvertToProtoTable(&scenario.ScenarioDataTableRow),
+		ScenarioDataTableRowIndex: scenario.ScenarioDataTableRowIndex,
+		ScenarioDataTable:         gauge.ConvertToProtoTable(scenario.DataTable.Table),
+	}
+	r.SetSkippedScenario()
+	r.ProtoScenario.SkipErrors = []string{reason}
+	e.specResult.ScenarioSkippedCount++
+	return r
+}
+
+func (e *specExecutor) isQuarantined(scenario *gauge.Scenario) bool {
+	return quarantine.Contains(e.specification.FileName, getTagValue(e.specification.Tags), scenario.Heading.Value, getTagValue(scenario.Tags))
+}
+
 func (e *specExecutor) addAllItemsForScenarioExecution(scenario *gauge.Scenario, scenarioResult *result.ScenarioResult) error {
 	contexts, err := e.getItemsForScenarioExecution(e.specification.Contexts)
 	if err != nil {
@@ -384,6 +442,15 @@ func setSpecFailure(executionInfo *gauge_messages.ExecutionInfo) {
 	executionInfo.CurrentSpec.IsFailed = true
 }
 
+// shouldExecuteForRows tells if the scenario is to be executed with the data table rows it is created for, as selected by --table-rows.
+// Row numbers select the rows of the spec data table, while a filter applies to the rows of both the spec and scenario data tables.
+func shouldExecuteForRows(scenario *gauge.Scenario) bool {
+	if tableRowsFilter != nil {
+		return tableRowsFilter.Matches(gauge.DataTableRow{Table: &scenario.ScenarioDataTableRow}, gauge.DataTableRow{Table: &scenario.SpecDataTableRow})
+	}
+	return !scenario.SpecDataTableRow.IsInitialized() || shouldExecuteForRow(scenario.SpecDataTableRowIndex)
+}
+
 func shouldExecuteForRow(i int) bool {
 	if len(tableRowsIndexes) < 1 {
 		return true
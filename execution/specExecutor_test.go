@@ -9,14 +9,19 @@ package execution
 import (
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/getgauge/gauge/runner"
 
 	"sync"
 
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/quarantine"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/parser"
@@ -747,3 +752,93 @@ func TestExecuteScenarioShoulHaveRetriesInfo(t *testing.T) {
 			se.currentExecutionInfo.CurrentScenario.Retries.CurrentRetry)
 	}
 }
+
+func TestExecuteShouldSkipRemainingScenariosOfSpecWhenTimeBudgetExceeded(t *testing.T) {
+	TimeBudget = time.Minute
+	defer func() {
+		TimeBudget = 0
+		startTimeBudget()
+	}()
+	startTimeBudget()
+	se := newSpecExecutor(exampleSpecWithScenarios, &mockRunner{}, nil, gauge.NewBuildErrors(), 0)
+	executed := 0
+	se.scenarioExecutor = &mockExecutor{
+		executeFunc: func(i gauge.Item, r result.Result) {
+			executed++
+			r.(*result.ScenarioResult).ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_PASSED
+			deadline = time.Now().Add(-time.Second)
+		},
+	}
+
+	res := se.execute(false, true, false)
+
+	if executed != 1 || res.ScenarioSkippedCount != 1 || res.Skipped {
+		t.Fatalf("Expected only the first scenario to be executed, got %d executed and %d skipped", executed, res.ScenarioSkippedCount)
+	}
+	skipErrors := res.ProtoSpec.Items[1].GetScenario().GetSkipErrors()
+	if len(skipErrors) != 1 || skipErrors[0] != "Skipped Reason: Time budget (1m0s) exceeded" {
+		t.Errorf("Expected time budget skip reason, got %v", skipErrors)
+	}
+}
+
+func TestExecuteScenarioShouldSkipScenarioWhenInterrupted(t *testing.T) {
+	interrupted.Store(true)
+	defer interrupted.Store(false)
+	se := newSpecExecutor(exampleSpecWithScenarios, &mockRunner{}, nil, gauge.NewBuildErrors(), 0)
+	se.specResult = gauge.NewSpecResult(exampleSpecWithScenarios)
+	se.scenarioExecutor = &mockExecutor{
+		executeFunc: func(i gauge.Item, r result.Result) {
+			t.Errorf("Expected scenario not to be executed after interrupt")
+		},
+	}
+
+	sceResult, _ := se.executeScenario(exampleSpecWithScenarios.Scenarios[0])
+
+	if !sceResult.GetSkippedScenario() || se.specResult.ScenarioSkippedCount != 1 {
+		t.Errorf("Expected scenario to be skipped, got %v", sceResult.ProtoScenario.GetExecutionStatus())
+	}
+	if skipErrors := sceResult.ProtoScenario.GetSkipErrors(); len(skipErrors) != 1 || skipErrors[0] != "Skipped Reason: Execution was interrupted" {
+		t.Errorf("Expected interrupt skip reason, got %v", skipErrors)
+	}
+}
+
+func TestExecuteScenarioTagsQuarantinedFailure(t *testing.T) {
+	MaxRetriesCount = 1
+	config.ProjectRoot = t.TempDir()
+	if err := os.WriteFile(filepath.Join(config.ProjectRoot, quarantine.File), []byte(`{"tags": ["known-bug"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := quarantine.Load(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		config.ProjectRoot = t.TempDir()
+		_ = quarantine.Load()
+		resetFailedScenariosCount()
+	}()
+	spec := &gauge.Specification{
+		Heading:  &gauge.Heading{Value: "Example Spec"},
+		FileName: "example.spec",
+		Scenarios: []*gauge.Scenario{
+			{Heading: &gauge.Heading{Value: "Known failure"}, Tags: &gauge.Tags{RawValues: [][]string{{"known-bug"}}}, Span: &gauge.Span{}},
+			{Heading: &gauge.Heading{Value: "Failure"}, Tags: &gauge.Tags{}, Span: &gauge.Span{}},
+		},
+	}
+	se := newSpecExecutor(spec, &mockRunner{}, nil, gauge.NewBuildErrors(), 0)
+	se.specResult = gauge.NewSpecResult(spec)
+	se.scenarioExecutor = &mockExecutor{
+		executeFunc: func(i gauge.Item, r result.Result) {
+			r.(*result.ScenarioResult).SetFailure()
+		},
+	}
+
+	quarantined, _ := se.executeScenario(spec.Scenarios[0])
+	failed, _ := se.executeScenario(spec.Scenarios[1])
+
+	if tags := quarantined.ProtoScenario.GetTags(); len(tags) != 2 || tags[1] != quarantine.Tag {
+		t.Errorf("Expected the quarantined failure to be tagged %s, got %v", quarantine.Tag, tags)
+	}
+	if tags := failed.ProtoScenario.GetTags(); len(tags) != 0 {
+		t.Errorf("Expected the other failure not to be tagged, got %v", tags)
+	}
+}
@@ -0,0 +1,102 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sort"
+
+	m "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+)
+
+// splitSpecsByScenarios splits the scenarios of every spec into at most the given number of parts.
+// Each part is a spec of its own with the same file name, so that the results are merged like data table rows.
+func splitSpecsByScenarios(specs []*gauge.Specification, parts int, errMap *gauge.BuildErrors) (splitSpecs []*gauge.Specification) {
+	for _, spec := range specs {
+		n := parts
+		if len(spec.Scenarios) < n {
+			n = len(spec.Scenarios)
+		}
+		if n <= 1 {
+			splitSpecs = append(splitSpecs, spec)
+			continue
+		}
+		start := 0
+		for i := 0; i < n; i++ {
+			size := len(spec.Scenarios) / n
+			if i < len(spec.Scenarios)%n {
+				size++
+			}
+			splitSpecs = append(splitSpecs, specWithScenarios(spec, spec.Scenarios[start:start+size], errMap))
+			start += size
+		}
+	}
+	return
+}
+
+func specWithScenarios(spec *gauge.Specification, scenarios []*gauge.Scenario, errMap *gauge.BuildErrors) *gauge.Specification {
+	included := make(map[*gauge.Scenario]bool)
+	for _, scn := range scenarios {
+		included[scn] = true
+	}
+	s := &gauge.Specification{
+		Heading:       spec.Heading,
+		Scenarios:     scenarios,
+		Comments:      spec.Comments,
+		DataTable:     spec.DataTable,
+		Contexts:      spec.Contexts,
+		FileName:      spec.FileName,
+		Tags:          spec.Tags,
+		TearDownSteps: spec.TearDownSteps,
+	}
+	for _, item := range spec.Items {
+		if scn, ok := item.(*gauge.Scenario); ok && !included[scn] {
+			continue
+		}
+		s.Items = append(s.Items, item)
+	}
+	if errMap != nil && len(errMap.SpecErrs[spec]) > 0 {
+		errMap.SpecErrs[s] = errMap.SpecErrs[spec]
+	}
+	return s
+}
+
+// sortByScenarioPosition orders the results of the parts of a spec by data table row and scenario position,
+// as the parts can finish in any order across the streams.
+func sortByScenarioPosition(results []*result.SpecResult) {
+	type position struct{ row, line int32 }
+	positions := make(map[*result.SpecResult]position)
+	for _, res := range results {
+		p := position{row: -1, line: -1}
+		for _, item := range res.ProtoSpec.GetItems() {
+			var scn *m.ProtoScenario
+			switch item.ItemType {
+			case m.ProtoItem_Scenario:
+				scn = item.Scenario
+			case m.ProtoItem_TableDrivenScenario:
+				scn = item.TableDrivenScenario.GetScenario()
+				if p.row == -1 {
+					p.row = item.TableDrivenScenario.TableRowIndex
+				}
+			default:
+				continue
+			}
+			if p.line == -1 && scn.GetSpan() != nil {
+				p.line = int32(scn.GetSpan().Start)
+			}
+		}
+		positions[res] = p
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		pi, pj := positions[results[i]], positions[results[j]]
+		if pi.row != pj.row {
+			return pi.row < pj.row
+		}
+		return pi.line < pj.line
+	})
+}
@@ -0,0 +1,85 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"errors"
+	"testing"
+
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func specWithScenarioCount(fileName string, count int) *gauge.Specification {
+	comment := &gauge.Comment{Value: "comment"}
+	spec := &gauge.Specification{FileName: fileName, Heading: &gauge.Heading{Value: fileName}, Items: []gauge.Item{comment}}
+	for i := 0; i < count; i++ {
+		scn := &gauge.Scenario{Heading: &gauge.Heading{Value: "scenario"}, Span: &gauge.Span{Start: i + 2}}
+		spec.Scenarios = append(spec.Scenarios, scn)
+		spec.Items = append(spec.Items, scn)
+	}
+	return spec
+}
+
+func TestSplitSpecsByScenarios(t *testing.T) {
+	spec := specWithScenarioCount("a.spec", 5)
+	single := specWithScenarioCount("b.spec", 1)
+	errMap := gauge.NewBuildErrors()
+	errMap.SpecErrs[spec] = []error{errors.New("error")}
+
+	got := splitSpecsByScenarios([]*gauge.Specification{spec, single}, 2, errMap)
+
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 specs. Got %d", len(got))
+	}
+	if len(got[0].Scenarios) != 3 || len(got[1].Scenarios) != 2 || got[2] != single {
+		t.Errorf("Expected scenarios to be split as 3, 2 and 1. Got %d, %d and %d", len(got[0].Scenarios), len(got[1].Scenarios), len(got[2].Scenarios))
+	}
+	if got[1].Scenarios[0] != spec.Scenarios[3] {
+		t.Errorf("Expected the second part to start with the fourth scenario.")
+	}
+	if len(got[1].Items) != 3 || got[1].Items[0].Kind() != gauge.CommentKind {
+		t.Errorf("Expected the second part to have the comment and its two scenarios as items. Got %v", got[1].Items)
+	}
+	for _, s := range got[:2] {
+		if s.FileName != spec.FileName {
+			t.Errorf("Expected file name %s. Got %s", spec.FileName, s.FileName)
+		}
+		if len(errMap.SpecErrs[s]) != 1 {
+			t.Errorf("Expected spec errors to be copied to the parts.")
+		}
+	}
+}
+
+func TestMergeOfSpecPartsIsOrderedByScenarioPosition(t *testing.T) {
+	ParallelGranularity = ScenarioGranularity
+	defer func() { ParallelGranularity = "" }()
+	part := func(heading string, line int64) *result.SpecResult {
+		return &result.SpecResult{ProtoSpec: &gm.ProtoSpec{FileName: "a.spec", Items: []*gm.ProtoItem{
+			{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ScenarioHeading: heading, Span: &gm.Span{Start: line}, ExecutionStatus: gm.ExecutionStatus_PASSED}},
+		}}}
+	}
+	res := &result.SuiteResult{SpecResults: []*result.SpecResult{part("third", 9), part("first", 2), part("second", 5)}}
+
+	got := mergeDataTableSpecResults(res)
+
+	if len(got.SpecResults) != 1 {
+		t.Fatalf("Expected one spec result. Got %d", len(got.SpecResults))
+	}
+	items := got.SpecResults[0].ProtoSpec.Items
+	var headings []string
+	for _, item := range items {
+		headings = append(headings, item.Scenario.ScenarioHeading)
+	}
+	if len(headings) != 3 || headings[0] != "first" || headings[1] != "second" || headings[2] != "third" {
+		t.Errorf("Expected scenarios in source order. Got %v", headings)
+	}
+	if got.SpecResults[0].ScenarioCount != 3 {
+		t.Errorf("Expected scenario count 3. Got %d", got.SpecResults[0].ScenarioCount)
+	}
+}
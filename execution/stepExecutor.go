@@ -7,6 +7,8 @@
 package execution
 
 import (
+	"time"
+
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
@@ -20,6 +22,8 @@ type stepExecutor struct {
 	pluginHandler        plugin.Handler
 	currentExecutionInfo *gauge_messages.ExecutionInfo
 	stream               int
+	timeout              time.Duration
+	timeoutMessage       string
 }
 
 // TODO: stepExecutor should not consume both gauge.Step and gauge_messages.ProtoStep. The usage of ProtoStep should be eliminated.
@@ -39,7 +43,10 @@ func (e *stepExecutor) executeStep(step *gauge.Step, protoStep *gauge_messages.P
 	e.notifyBeforeStepHook(stepResult)
 	if !stepResult.GetFailed() {
 		executeStepMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep, ExecuteStepRequest: stepRequest}
-		stepExecutionStatus := e.runner.ExecuteAndGetStatus(executeStepMessage)
+		stepExecutionStatus, timedOut, sent := executeWithTimeout(e.runner, executeStepMessage, e.timeout, e.timeoutMessage)
+		if timedOut && sent {
+			recoverRunner(e.runner)
+		}
 		stepExecutionStatus.Message = append(stepResult.ProtoStepExecResult().GetExecutionResult().Message, stepExecutionStatus.Message...)
 		if stepExecutionStatus.GetFailed() {
 			e.currentExecutionInfo.CurrentStep.ErrorMessage = stepExecutionStatus.GetErrorMessage()
@@ -8,6 +8,7 @@ package execution
 
 import (
 	"testing"
+	"time"
 
 	"github.com/getgauge/gauge/gauge"
 
@@ -195,3 +196,39 @@ func TestStepExecutionShouldGetScreenshotsAfterStep(t *testing.T) {
 		}
 	}
 }
+
+func TestStepExecutionShouldFailWhenStepTimesOut(t *testing.T) {
+	r := &mockRunner{}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	hookCalled := false
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		switch m.MessageType {
+		case gauge_messages.Message_ExecuteStep:
+			time.Sleep(time.Second)
+		case gauge_messages.Message_StepExecutionEnding:
+			hookCalled = true
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	ei := &gauge_messages.ExecutionInfo{CurrentScenario: &gauge_messages.ScenarioInfo{}, CurrentSpec: &gauge_messages.SpecInfo{}}
+	se := &stepExecutor{runner: r, pluginHandler: h, currentExecutionInfo: ei, stream: 0, timeout: 10 * time.Millisecond, timeoutMessage: "Step timed out after 10ms"}
+	step := &gauge.Step{
+		Value:     "a simple step",
+		LineText:  "a simple step",
+		Fragments: []*gauge_messages.Fragment{{FragmentType: gauge_messages.Fragment_Text, Text: "a simple step"}},
+	}
+	protoStep := gauge.ConvertToProtoItem(step).GetStep()
+	protoStep.StepExecutionResult = &gauge_messages.ProtoStepExecutionResult{}
+
+	stepResult := se.executeStep(step, protoStep)
+
+	if !stepResult.GetFailed() {
+		t.Fatal("Expected step to fail with a timeout")
+	}
+	if stepResult.GetErrorMessage() != "Step timed out after 10ms" {
+		t.Errorf("Expected timeout error message, got : %s", stepResult.GetErrorMessage())
+	}
+	if !hookCalled {
+		t.Error("Expected after step hook to be executed after timeout")
+	}
+}
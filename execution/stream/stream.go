@@ -0,0 +1,301 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package stream serves the execution events live on a local endpoint, so that dashboards and other tools can follow
+// the progress of an execution without being a plugin. Events are sent as the messages which reporting plugins receive,
+// either as JSON, one per line, or as length delimited protobuf.
+package stream
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	eventsPath  = "/events"
+	jsonFormat  = "json"
+	protoFormat = "proto"
+	// bufferSize is the number of events kept for a subscriber which is slower than the execution, before it is dropped.
+	bufferSize = 1024
+	// drainTimeout is how long the events of a suite are waited for to be sent to the subscribers, before gauge exits.
+	drainTimeout = 5 * time.Second
+)
+
+type server struct {
+	mutex       sync.Mutex
+	subscribers map[*subscriber]bool
+}
+
+type subscriber struct {
+	messages chan *gm.Message
+	// pending counts the messages which are yet to be sent to the subscriber.
+	pending sync.WaitGroup
+}
+
+var current *server
+
+// Start serves the execution events on the given address, like 127.0.0.1:9000, until gauge exits. The events are served
+// on the loopback interface if no host is given, and on an address reachable from other hosts only if allowRemote is set.
+// Subscribers get the events from /events, in JSON by default, or in protobuf with the query ?format=proto.
+func Start(address string, allowRemote bool) error {
+	if current != nil {
+		return nil
+	}
+	address, local, err := util.ListenAddress(address)
+	if err != nil {
+		return err
+	}
+	if !local && !allowRemote {
+		return fmt.Errorf("%s is reachable from other hosts. Use a loopback address, like 127.0.0.1, or allow other hosts to subscribe with --events-allow-remote", address)
+	}
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	current = newServer()
+	go func() {
+		if err := http.Serve(l, current); err != nil {
+			logger.Errorf(false, "Stopped streaming execution events. %s", err.Error())
+		}
+	}()
+	logger.Infof(true, "Streaming execution events on http://%s%s", l.Addr().String(), eventsPath)
+	return nil
+}
+
+// ListenExecutionEvents listens to all the execution events and sends them to the subscribers of the stream.
+// The events are converted to messages as they are notified, and sent to the subscribers without holding up the execution.
+func ListenExecutionEvents(wg *sync.WaitGroup) {
+	if current == nil {
+		return
+	}
+	event.RegisterHandler(func(e event.ExecutionEvent) {
+		if m := toMessage(e); m != nil {
+			current.broadcast(m)
+		}
+	}, event.SuiteStart, event.SpecStart, event.SpecEnd, event.ScenarioStart, event.ScenarioEnd, event.StepStart, event.StepEnd, event.ConceptStart, event.ConceptEnd, event.SuiteEnd)
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				current.drain(drainTimeout)
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func newServer() *server {
+	return &server{subscribers: make(map[*subscriber]bool)}
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != eventsPath {
+		http.NotFound(w, r)
+		return
+	}
+	var write func(*gm.Message) error
+	switch format := r.URL.Query().Get("format"); format {
+	case "", jsonFormat:
+		sse := strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+		if sse {
+			w.Header().Set("Content-Type", "text/event-stream")
+		} else {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+		}
+		write = func(m *gm.Message) error {
+			b, err := protojson.Marshal(m)
+			if err != nil {
+				return err
+			}
+			if sse {
+				_, err = fmt.Fprintf(w, "data: %s\n\n", b)
+			} else {
+				_, err = fmt.Fprintf(w, "%s\n", b)
+			}
+			return err
+		}
+	case protoFormat:
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		write = func(m *gm.Message) error {
+			_, err := protodelim.MarshalTo(w, m)
+			return err
+		}
+	default:
+		http.Error(w, fmt.Sprintf("Invalid format '%s'. Format should be %s or %s.", format, jsonFormat, protoFormat), http.StatusBadRequest)
+		return
+	}
+	flusher, _ := w.(http.Flusher)
+	w.WriteHeader(http.StatusOK)
+	if flusher != nil {
+		flusher.Flush()
+	}
+	sub := s.subscribe()
+	defer s.unsubscribe(sub)
+	for {
+		select {
+		case m, ok := <-sub.messages:
+			if !ok {
+				return
+			}
+			err := write(m)
+			sub.pending.Done()
+			if err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func (s *server) subscribe() *subscriber {
+	sub := &subscriber{messages: make(chan *gm.Message, bufferSize)}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.subscribers[sub] = true
+	return sub
+}
+
+func (s *server) unsubscribe(sub *subscriber) {
+	s.mutex.Lock()
+	delete(s.subscribers, sub)
+	s.mutex.Unlock()
+	for {
+		select {
+		case _, ok := <-sub.messages:
+			if !ok {
+				return
+			}
+			sub.pending.Done()
+		default:
+			return
+		}
+	}
+}
+
+// broadcast sends the message to every subscriber. A subscriber which cannot keep up with the execution is dropped,
+// so that the execution is never held up by the subscribers.
+func (s *server) broadcast(m *gm.Message) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for sub := range s.subscribers {
+		sub.pending.Add(1)
+		select {
+		case sub.messages <- m:
+		default:
+			sub.pending.Done()
+			delete(s.subscribers, sub)
+			close(sub.messages)
+			logger.Debugf(true, "Dropped a subscriber of the execution events which could not keep up with the execution")
+		}
+	}
+}
+
+// drain waits for the messages sent so far to reach the subscribers, up to the given timeout.
+func (s *server) drain(timeout time.Duration) {
+	s.mutex.Lock()
+	var subs []*subscriber
+	for sub := range s.subscribers {
+		subs = append(subs, sub)
+	}
+	s.mutex.Unlock()
+	done := make(chan struct{})
+	go func() {
+		for _, sub := range subs {
+			sub.pending.Wait()
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
+}
+
+// toMessage converts the execution event to the message a reporting plugin receives for it. It is called on the goroutine
+// notifying the event, so that the message is a copy of the results as they were when notified, which is then sent to
+// the subscribers while the execution goes on updating the results.
+func toMessage(e event.ExecutionEvent) *gm.Message {
+	stream := int32(e.Stream)
+	var m *gm.Message
+	switch e.Topic {
+	case event.SuiteStart:
+		m = &gm.Message{MessageType: gm.Message_ExecutionStarting, ExecutionStartingRequest: &gm.ExecutionStartingRequest{CurrentExecutionInfo: e.ExecutionInfo, Stream: stream}}
+	case event.SpecStart:
+		m = &gm.Message{MessageType: gm.Message_SpecExecutionStarting, SpecExecutionStartingRequest: &gm.SpecExecutionStartingRequest{CurrentExecutionInfo: e.ExecutionInfo, SpecResult: gauge.ConvertToProtoSpecResult(e.Result.(*result.SpecResult)), Stream: stream}}
+	case event.SpecEnd:
+		m = &gm.Message{MessageType: gm.Message_SpecExecutionEnding, SpecExecutionEndingRequest: &gm.SpecExecutionEndingRequest{CurrentExecutionInfo: e.ExecutionInfo, SpecResult: gauge.ConvertToProtoSpecResult(e.Result.(*result.SpecResult)), Stream: stream}}
+	case event.ScenarioStart:
+		m = &gm.Message{MessageType: gm.Message_ScenarioExecutionStarting, ScenarioExecutionStartingRequest: &gm.ScenarioExecutionStartingRequest{CurrentExecutionInfo: e.ExecutionInfo, ScenarioResult: gauge.ConvertToProtoScenarioResult(e.Result.(*result.ScenarioResult)), Stream: stream}}
+	case event.ScenarioEnd:
+		m = &gm.Message{MessageType: gm.Message_ScenarioExecutionEnding, ScenarioExecutionEndingRequest: &gm.ScenarioExecutionEndingRequest{CurrentExecutionInfo: e.ExecutionInfo, ScenarioResult: gauge.ConvertToProtoScenarioResult(e.Result.(*result.ScenarioResult)), Stream: stream}}
+	case event.StepStart:
+		stepResult := &gm.ProtoStepResult{ProtoItem: gauge.ConvertToProtoItem(e.Item.(*gauge.Step))}
+		m = &gm.Message{MessageType: gm.Message_StepExecutionStarting, StepExecutionStartingRequest: &gm.StepExecutionStartingRequest{CurrentExecutionInfo: e.ExecutionInfo, StepResult: stepResult, Stream: stream}}
+	case event.StepEnd:
+		m = &gm.Message{MessageType: gm.Message_StepExecutionEnding, StepExecutionEndingRequest: &gm.StepExecutionEndingRequest{CurrentExecutionInfo: e.ExecutionInfo, StepResult: gauge.ConvertToProtoStepResult(e.Result.(*result.StepResult)), Stream: stream}}
+	case event.ConceptStart:
+		stepResult := &gm.ProtoStepResult{ProtoItem: gauge.ConvertToProtoItem(e.Item.(*gauge.Step))}
+		m = &gm.Message{MessageType: gm.Message_ConceptExecutionStarting, ConceptExecutionStartingRequest: &gm.ConceptExecutionStartingRequest{CurrentExecutionInfo: e.ExecutionInfo, StepResult: stepResult, Stream: stream}}
+	case event.ConceptEnd:
+		stepResult := &gm.ProtoStepResult{ProtoItem: &gm.ProtoItem{ItemType: gm.ProtoItem_Concept, Concept: e.Result.(*result.ConceptResult).ProtoConcept}}
+		m = &gm.Message{MessageType: gm.Message_ConceptExecutionEnding, ConceptExecutionEndingRequest: &gm.ConceptExecutionEndingRequest{CurrentExecutionInfo: e.ExecutionInfo, StepResult: stepResult, Stream: stream}}
+	case event.SuiteEnd:
+		m = &gm.Message{MessageType: gm.Message_SuiteExecutionResult, SuiteExecutionResult: &gm.SuiteExecutionResult{SuiteResult: gauge.ConvertToProtoSuiteResult(e.Result.(*result.SuiteResult))}}
+	default:
+		return nil
+	}
+	m = proto.Clone(m).(*gm.Message)
+	maskSecrets(m.ProtoReflect())
+	return m
+}
+
+// maskSecrets hides the values of the secret properties among the parameters of the steps in the message, like the console does.
+func maskSecrets(m protoreflect.Message) {
+	if step, ok := m.Interface().(*gm.ProtoStep); ok {
+		for _, f := range step.GetFragments() {
+			if p := f.GetParameter(); p != nil {
+				p.Value = env.MaskSecret(p.GetValue())
+			}
+		}
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap() || fd.Message() == nil:
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				maskSecrets(v.List().Get(i).Message())
+			}
+		default:
+			maskSecrets(v.Message())
+		}
+		return true
+	})
+}
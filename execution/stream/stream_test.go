@@ -0,0 +1,205 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package stream
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestToMessageForScenarioEnd(t *testing.T) {
+	scn := &gm.ProtoScenario{ScenarioHeading: "Login", ExecutionStatus: gm.ExecutionStatus_FAILED}
+	info := &gm.ExecutionInfo{CurrentSpec: &gm.SpecInfo{Name: "Users"}}
+
+	m := toMessage(event.NewExecutionEvent(event.ScenarioEnd, nil, result.NewScenarioResult(scn), 2, info))
+
+	if m.GetMessageType() != gm.Message_ScenarioExecutionEnding {
+		t.Fatalf("Expected a scenario execution ending message. Got %s", m.GetMessageType())
+	}
+	req := m.GetScenarioExecutionEndingRequest()
+	if req.GetStream() != 2 {
+		t.Errorf("Expected stream 2. Got %d", req.GetStream())
+	}
+	if req.GetCurrentExecutionInfo().GetCurrentSpec().GetName() != "Users" {
+		t.Errorf("Expected the execution info of spec Users. Got %v", req.GetCurrentExecutionInfo())
+	}
+	if got := req.GetScenarioResult().GetProtoItem().GetScenario(); got.GetScenarioHeading() != "Login" || got.GetExecutionStatus() != gm.ExecutionStatus_FAILED {
+		t.Errorf("Expected the result of scenario Login. Got %v", got)
+	}
+	if req.GetScenarioResult().GetProtoItem().GetScenario() == scn {
+		t.Errorf("Expected the result to be copied")
+	}
+}
+
+func TestServerStreamsEventsAsJSON(t *testing.T) {
+	s := newServer()
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + eventsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	waitForSubscribers(t, s, 1)
+	s.broadcast(toMessage(event.NewExecutionEvent(event.SuiteStart, nil, nil, 0, &gm.ExecutionInfo{})))
+	s.broadcast(toMessage(event.NewExecutionEvent(event.SuiteEnd, nil, &result.SuiteResult{IsFailed: true}, 0, &gm.ExecutionInfo{})))
+
+	r := bufio.NewReader(res.Body)
+	var types []gm.Message_MessageType
+	for i := 0; i < 2; i++ {
+		line, err := r.ReadBytes('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := &gm.Message{}
+		if err := protojson.Unmarshal(line, m); err != nil {
+			t.Fatalf("Expected a message in JSON. Got %s. %s", line, err.Error())
+		}
+		types = append(types, m.GetMessageType())
+		if m.GetMessageType() == gm.Message_SuiteExecutionResult && !m.GetSuiteExecutionResult().GetSuiteResult().GetFailed() {
+			t.Errorf("Expected the suite result to be failed")
+		}
+	}
+	if types[0] != gm.Message_ExecutionStarting || types[1] != gm.Message_SuiteExecutionResult {
+		t.Errorf("Expected the execution starting and suite result messages. Got %v", types)
+	}
+}
+
+func TestServerStreamsEventsAsProtobuf(t *testing.T) {
+	s := newServer()
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + eventsPath + "?format=proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	waitForSubscribers(t, s, 1)
+	s.broadcast(toMessage(event.NewExecutionEvent(event.SpecStart, nil, &result.SpecResult{ProtoSpec: &gm.ProtoSpec{SpecHeading: "Users"}}, 1, &gm.ExecutionInfo{})))
+
+	m := &gm.Message{}
+	if err := protodelim.UnmarshalFrom(bufio.NewReader(res.Body), m); err != nil {
+		t.Fatal(err)
+	}
+	if got := m.GetSpecExecutionStartingRequest().GetSpecResult().GetProtoSpec().GetSpecHeading(); got != "Users" {
+		t.Errorf("Expected the spec execution starting message of spec Users. Got %v", m)
+	}
+}
+
+func TestServerRejectsUnknownFormat(t *testing.T) {
+	ts := httptest.NewServer(newServer())
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + eventsPath + "?format=xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status %d. Got %d", http.StatusBadRequest, res.StatusCode)
+	}
+}
+
+func TestDrainWaitsForSubscribers(t *testing.T) {
+	s := newServer()
+	sub := s.subscribe()
+	s.broadcast(&gm.Message{MessageType: gm.Message_ExecutionStarting})
+
+	go func() {
+		<-sub.messages
+		time.Sleep(10 * time.Millisecond)
+		sub.pending.Done()
+	}()
+	start := time.Now()
+	s.drain(time.Second)
+
+	if time.Since(start) >= time.Second {
+		t.Errorf("Expected drain to return once the subscriber got the message")
+	}
+}
+
+func waitForSubscribers(t *testing.T, s *server, n int) {
+	for i := 0; i < 100; i++ {
+		s.mutex.Lock()
+		count := len(s.subscribers)
+		s.mutex.Unlock()
+		if count >= n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Expected %d subscribers", n)
+}
+
+func TestListenExecutionEventsCopiesResultsWhenNotified(t *testing.T) {
+	event.InitRegistry()
+	defer event.InitRegistry()
+	current = newServer()
+	defer func() { current = nil }()
+	sub := current.subscribe()
+	var wg sync.WaitGroup
+	ListenExecutionEvents(&wg)
+	scn := &gm.ProtoScenario{ScenarioHeading: "Login", ExecutionStatus: gm.ExecutionStatus_PASSED}
+
+	event.Notify(event.NewExecutionEvent(event.ScenarioStart, nil, result.NewScenarioResult(scn), 0, &gm.ExecutionInfo{}))
+	scn.ExecutionStatus = gm.ExecutionStatus_FAILED
+
+	m := <-sub.messages
+	sub.pending.Done()
+	if got := m.GetScenarioExecutionStartingRequest().GetScenarioResult().GetProtoItem().GetScenario().GetExecutionStatus(); got != gm.ExecutionStatus_PASSED {
+		t.Errorf("Expected the result as it was when notified. Got %s", got)
+	}
+	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, &result.SuiteResult{}, 0, &gm.ExecutionInfo{}))
+	<-sub.messages
+	sub.pending.Done()
+	wg.Wait()
+}
+
+func TestToMessageMasksSecretParameters(t *testing.T) {
+	secretValues := env.SecretValues
+	env.SecretValues = func() []string { return []string{"s3cret"} }
+	defer func() { env.SecretValues = secretValues }()
+	param := &gm.Parameter{ParameterType: gm.Parameter_Special_String, Value: "s3cret"}
+	step := &gm.ProtoItem{ItemType: gm.ProtoItem_Step, Step: &gm.ProtoStep{Fragments: []*gm.Fragment{
+		{FragmentType: gm.Fragment_Text, Text: "Login with "},
+		{FragmentType: gm.Fragment_Parameter, Parameter: param},
+	}}}
+	scn := &gm.ProtoScenario{ScenarioHeading: "Login", ScenarioItems: []*gm.ProtoItem{step}}
+
+	m := toMessage(event.NewExecutionEvent(event.ScenarioEnd, nil, result.NewScenarioResult(scn), 0, &gm.ExecutionInfo{}))
+
+	got := m.GetScenarioExecutionEndingRequest().GetScenarioResult().GetProtoItem().GetScenario().GetScenarioItems()[0].GetStep().GetFragments()[1].GetParameter()
+	if got.GetValue() != "******" {
+		t.Errorf("Expected the secret parameter to be masked. Got %s", got.GetValue())
+	}
+	if param.GetValue() != "s3cret" {
+		t.Errorf("Expected the parameter of the result not to be changed. Got %s", param.GetValue())
+	}
+}
+
+func TestStartRejectsAddressReachableFromOtherHosts(t *testing.T) {
+	if err := Start("0.0.0.0:0", false); err == nil {
+		t.Fatal("Expected an address reachable from other hosts to be rejected")
+	}
+	if current != nil {
+		t.Errorf("Expected the events not to be streamed")
+	}
+}
@@ -0,0 +1,105 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"strings"
+	"time"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/runner"
+)
+
+const (
+	// scenarioTimeoutTag limits the execution time of a scenario, e.g. timeout:2m
+	scenarioTimeoutTag = "timeout"
+	// stepTimeoutTag limits the execution time of every step in a scenario, e.g. step-timeout:30s
+	stepTimeoutTag = "step-timeout"
+)
+
+// timeouts holds the time limits applicable for a scenario. A zero value means no limit.
+type timeouts struct {
+	step     time.Duration
+	scenario time.Duration
+}
+
+// getTimeouts resolves the timeouts from the environment and overrides them with the timeout tags.
+// Tags are applied in the given order, so scenario tags should come after spec tags.
+func getTimeouts(tagLists ...[]string) timeouts {
+	t := timeouts{step: env.StepTimeout(), scenario: env.ScenarioTimeout()}
+	for _, tags := range tagLists {
+		for _, tag := range tags {
+			if d, ok := tagTimeout(tag, scenarioTimeoutTag); ok {
+				t.scenario = d
+			}
+			if d, ok := tagTimeout(tag, stepTimeoutTag); ok {
+				t.step = d
+			}
+		}
+	}
+	return t
+}
+
+func tagTimeout(tag, name string) (time.Duration, bool) {
+	key, value, found := strings.Cut(tag, ":")
+	if !found || !strings.EqualFold(strings.TrimSpace(key), name) {
+		return 0, false
+	}
+	d, err := env.ParseDuration(value)
+	if err != nil {
+		logger.Warningf(true, "Ignoring tag '%s'. Invalid duration: %s", tag, err.Error())
+		return 0, false
+	}
+	return d, true
+}
+
+// executeWithTimeout sends the message to the runner and waits for the response for at most the given duration.
+// A zero timeout waits until the runner responds, a negative one means the time is already up and the message is not sent.
+// The returned flags report if the timeout elapsed, and if the message was sent to the runner.
+func executeWithTimeout(r runner.Runner, m *gauge_messages.Message, timeout time.Duration, message string) (res *gauge_messages.ProtoExecutionResult, timedOut bool, sent bool) {
+	if timeout == 0 {
+		return r.ExecuteAndGetStatus(m), false, true
+	}
+	if timeout < 0 {
+		return timeoutResult(message, 0), true, false
+	}
+	resChan := make(chan *gauge_messages.ProtoExecutionResult, 1)
+	go func() {
+		resChan <- r.ExecuteAndGetStatus(m)
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case res := <-resChan:
+		return res, false, true
+	case <-timer.C:
+		return timeoutResult(message, timeout), true, true
+	}
+}
+
+func timeoutResult(message string, elapsed time.Duration) *gauge_messages.ProtoExecutionResult {
+	return &gauge_messages.ProtoExecutionResult{
+		Failed:           true,
+		RecoverableError: false,
+		ErrorMessage:     message,
+		ExecutionTime:    elapsed.Milliseconds(),
+	}
+}
+
+// recoverRunner restarts the runner after a timeout, as the runner may still be executing the step which timed out
+// and cannot be relied upon to respond to the next one. Only the runner owned by the stream is restarted. A runner
+// shared by the streams of the execution is left running, as the other streams are executing with it.
+func recoverRunner(r runner.Runner) {
+	rr, ok := asRestartable(r)
+	if !ok {
+		logger.Warningf(true, "Not restarting the runner after the timeout, as it is shared by the streams of the execution.")
+		return
+	}
+	restartRunner(rr)
+}
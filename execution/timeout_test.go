@@ -0,0 +1,147 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/runner"
+)
+
+func TestGetTimeoutsFromEnv(t *testing.T) {
+	os.Setenv("step_timeout", "30s")
+	os.Setenv("scenario_timeout", "120000")
+	defer os.Unsetenv("step_timeout")
+	defer os.Unsetenv("scenario_timeout")
+
+	got := getTimeouts()
+
+	if got.step != 30*time.Second || got.scenario != 2*time.Minute {
+		t.Errorf("Expected step timeout 30s and scenario timeout 2m, got %s and %s", got.step, got.scenario)
+	}
+}
+
+func TestGetTimeoutsScenarioTagsOverrideSpecTags(t *testing.T) {
+	got := getTimeouts([]string{"timeout:1m", "step-timeout:5s", "smoke"}, []string{"Timeout:10s"})
+
+	if got.step != 5*time.Second || got.scenario != 10*time.Second {
+		t.Errorf("Expected step timeout 5s and scenario timeout 10s, got %s and %s", got.step, got.scenario)
+	}
+}
+
+func TestGetTimeoutsIgnoresInvalidTags(t *testing.T) {
+	got := getTimeouts([]string{"timeout:soon", "timeout"})
+
+	if got.scenario != 0 {
+		t.Errorf("Expected no scenario timeout, got %s", got.scenario)
+	}
+}
+
+func TestExecuteWithTimeoutDoesNotSendMessageWhenTimeIsUp(t *testing.T) {
+	called := false
+	r := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		called = true
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+
+	res, timedOut, sent := executeWithTimeout(r, &gauge_messages.Message{}, -1, "Scenario timed out after 1s")
+
+	if called || sent || !timedOut || !res.GetFailed() || res.GetErrorMessage() != "Scenario timed out after 1s" {
+		t.Errorf("Expected a timeout failure without invoking the runner, got %v", res)
+	}
+}
+
+func TestRecoverRunnerRestartsTheRunnerEvenIfItResponds(t *testing.T) {
+	defer func() {
+		startNewRunner = runner.Start
+	}()
+	restarted := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+	startNewRunner = func(*manifest.Manifest, int, chan bool, bool) (runner.Runner, error) {
+		return restarted, nil
+	}
+	MaxRunnerRestarts = 1
+	resetRunnerRestartsCount()
+	defer func() { MaxRunnerRestarts = 0 }()
+	r := newRestartableRunner(&mockRunner{}, &manifest.Manifest{Language: "java"}, 0)
+
+	recoverRunner(&keepAliveRunner{r})
+
+	if r.get() != restarted {
+		t.Errorf("Expected runner to be restarted after a timeout")
+	}
+}
+
+func TestRecoverRunnerCountsRestartsAgainstMaxRunnerRestarts(t *testing.T) {
+	defer func() {
+		startNewRunner = runner.Start
+	}()
+	startNewRunner = func(*manifest.Manifest, int, chan bool, bool) (runner.Runner, error) {
+		t.Errorf("Expected runner not to be restarted")
+		return nil, nil
+	}
+	MaxRunnerRestarts = 1
+	resetRunnerRestartsCount()
+	runnerRestartsCount.Add(1)
+	defer func() { MaxRunnerRestarts = 0 }()
+
+	recoverRunner(newRestartableRunner(&mockRunner{}, &manifest.Manifest{Language: "java"}, 0))
+}
+
+func TestRecoverRunnerDoesNotKillASharedRunner(t *testing.T) {
+	r := &killRecordingRunner{}
+
+	recoverRunner(r)
+
+	if r.killed {
+		t.Errorf("Expected the runner shared by the streams not to be killed")
+	}
+}
+
+type killRecordingRunner struct {
+	mockRunner
+	killed bool
+}
+
+func (r *killRecordingRunner) Kill() error {
+	r.killed = true
+	return nil
+}
+
+type runnerGroup struct {
+	mockRunner
+	runners []runner.Runner
+}
+
+func (r *runnerGroup) Runners() []runner.Runner {
+	return r.runners
+}
+
+func TestForceKillKillsEveryRunnerOfAGroup(t *testing.T) {
+	java, js := &killRecordingRunner{}, &killRecordingRunner{}
+
+	forceKill(&runnerGroup{runners: []runner.Runner{java, js}})
+
+	if !java.killed || !js.killed {
+		t.Errorf("Expected every runner of the group to be killed, got java: %t, js: %t", java.killed, js.killed)
+	}
+}
+
+func TestScenarioExecutorStepTimeoutIsBoundedByScenarioDeadline(t *testing.T) {
+	e := &scenarioExecutor{timeouts: timeouts{step: time.Minute, scenario: time.Second}, deadline: time.Now().Add(time.Second)}
+
+	timeout, message := e.stepTimeout()
+
+	if timeout > time.Second || message != "Scenario timed out after 1s" {
+		t.Errorf("Expected step to be bounded by scenario timeout, got %s (%s)", timeout, message)
+	}
+}
@@ -0,0 +1,129 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package timing records how long each spec took to execute, so that later runs can use it to plan the execution.
+package timing
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const timingsFile = "timings.json"
+
+// timings holds the execution time in milliseconds of each spec, keyed by the spec path relative to the project root.
+type timings struct {
+	Specs map[string]int64 `json:"specs"`
+}
+
+func newTimings() *timings {
+	return &timings{Specs: make(map[string]int64)}
+}
+
+// ListenSuiteEndAndSaveTimings listens to the suite end event and records the execution time of the executed specs.
+// Timings of specs which were not executed in this run are retained.
+func ListenSuiteEndAndSaveTimings(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				t := readTimings()
+				t.update(e.Result.(*result.SuiteResult))
+				writeTimings(t)
+				wg.Done()
+				return
+			}
+		}
+	}()
+}
+
+func (t *timings) update(res *result.SuiteResult) {
+	executed := make(map[string]int64)
+	for _, r := range res.SpecResults {
+		if r.Skipped || r.ProtoSpec == nil {
+			continue
+		}
+		executed[util.RelPathToProjectRoot(r.ProtoSpec.GetFileName())] += r.ExecutionTime
+	}
+	for spec, d := range executed {
+		t.Specs[spec] = d
+	}
+}
+
+// SpecDurations returns the execution time in milliseconds of the specs recorded in previous runs,
+// keyed by the spec path relative to the project root.
+var SpecDurations = func() map[string]int64 {
+	return readTimings().Specs
+}
+
+// ReadSpecDurations reads the execution time in milliseconds of the specs from the given timings file, like one shared
+// by the machines which execute the groups of a suite.
+func ReadSpecDurations(file string) (map[string]int64, error) {
+	contents, err := common.ReadFileContents(file)
+	if err != nil {
+		return nil, err
+	}
+	t := newTimings()
+	if err = json.Unmarshal([]byte(contents), t); err != nil {
+		return nil, fmt.Errorf("Invalid spec timings in %s. %s", file, err.Error())
+	}
+	if t.Specs == nil {
+		t.Specs = make(map[string]int64)
+	}
+	return t.Specs, nil
+}
+
+func readTimings() *timings {
+	t := newTimings()
+	file := filepath.Join(config.ProjectRoot, common.DotGauge, timingsFile)
+	if !common.FileExists(file) {
+		return t
+	}
+	contents, err := common.ReadFileContents(file)
+	if err != nil {
+		logger.Debugf(true, "Failed to read spec timings. Reason: %s", err.Error())
+		return t
+	}
+	if err = json.Unmarshal([]byte(contents), t); err != nil {
+		logger.Debugf(true, "Ignoring invalid spec timings in %s. Reason: %s", file, err.Error())
+		return newTimings()
+	}
+	if t.Specs == nil {
+		t.Specs = make(map[string]int64)
+	}
+	return t
+}
+
+func writeTimings(t *timings) {
+	dotGaugeDir := filepath.Join(config.ProjectRoot, common.DotGauge)
+	file := filepath.Join(dotGaugeDir, timingsFile)
+	if err := os.MkdirAll(dotGaugeDir, common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", dotGaugeDir, err.Error())
+		return
+	}
+	contents, err := json.MarshalIndent(t, "", "\t")
+	if err != nil {
+		logger.Errorf(true, "Failed to save spec timings. Reason: %s", err.Error())
+		return
+	}
+	if err = os.WriteFile(file, contents, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", file, err.Error())
+	}
+}
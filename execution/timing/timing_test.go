@@ -0,0 +1,77 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package timing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+)
+
+func specResult(fileName string, time int64, skipped bool) *result.SpecResult {
+	return &result.SpecResult{
+		ProtoSpec:     &gauge_messages.ProtoSpec{FileName: filepath.Join(config.ProjectRoot, fileName)},
+		ExecutionTime: time,
+		Skipped:       skipped,
+	}
+}
+
+func TestTimingsAreSavedAndRetainedAcrossRuns(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+
+	first := newTimings()
+	first.update(&result.SuiteResult{SpecResults: []*result.SpecResult{specResult("a.spec", 100, false), specResult("b.spec", 200, false)}})
+	writeTimings(first)
+
+	second := readTimings()
+	second.update(&result.SuiteResult{SpecResults: []*result.SpecResult{specResult("a.spec", 150, false), specResult("b.spec", 0, true)}})
+	writeTimings(second)
+
+	got := SpecDurations()
+	if len(got) != 2 || got["a.spec"] != 150 || got["b.spec"] != 200 {
+		t.Errorf("Expected a.spec to be updated and b.spec to be retained. Got %v", got)
+	}
+}
+
+func TestReadSpecDurationsFromFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "timings.json")
+	if err := os.WriteFile(file, []byte(`{"specs": {"a.spec": 100}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadSpecDurations(file)
+
+	if err != nil || len(got) != 1 || got["a.spec"] != 100 {
+		t.Errorf("Expected duration of a.spec to be 100. Got %v, %v", got, err)
+	}
+	if _, err := ReadSpecDurations(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("Expected an error for a missing timings file")
+	}
+}
+
+func TestTimingsOfDataTableRowsAreAdded(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+
+	ti := newTimings()
+	ti.update(&result.SuiteResult{SpecResults: []*result.SpecResult{specResult("a.spec", 100, false), specResult("a.spec", 50, false)}})
+
+	if ti.Specs["a.spec"] != 150 {
+		t.Errorf("Expected duration of a.spec to be 150. Got %d", ti.Specs["a.spec"])
+	}
+}
+
+func TestReadTimingsWithoutFile(t *testing.T) {
+	config.ProjectRoot = t.TempDir()
+
+	if got := SpecDurations(); len(got) != 0 {
+		t.Errorf("Expected no timings. Got %v", got)
+	}
+}
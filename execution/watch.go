@@ -0,0 +1,329 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/api"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/validation"
+)
+
+// watchDelay is the time to wait for more changes before executing, as editors tend to write a file more than once on save.
+const watchDelay = 300 * time.Millisecond
+
+var indexedSpec = regexp.MustCompile(`:\d+$`)
+
+// keepAliveRunner lets the runner outlive an execution in watch mode, as an execution kills its runner when done.
+type keepAliveRunner struct {
+	runner.Runner
+}
+
+func (r *keepAliveRunner) Kill() error {
+	return nil
+}
+
+type specWatcher struct {
+	specDirs  []string
+	runner    *restartableRunner
+	concepts  *gauge.ConceptDictionary
+	snapshots map[string]*specSnapshot
+	// implFiles holds the files with step implementations. It is nil if the runner cannot list them.
+	implFiles map[string]bool
+}
+
+// Watch executes the specs, and then watches the project for changes to specs, concepts and step implementations.
+// After every change only the affected scenarios are executed again, using the same runner.
+// The runner is restarted only when step implementations change. Watch returns only if the project cannot be watched.
+func Watch(specDirs []string) int {
+	if err := validateFlags(); err != nil {
+		logger.Fatal(true, err.Error())
+	}
+	setupExecution()
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		logger.Fatal(true, err.Error())
+	}
+	w := &specWatcher{specDirs: specDirs, runner: newRestartableRunner(startRunner(), m, 0), snapshots: make(map[string]*specSnapshot)}
+	w.refreshImplementationFiles()
+	w.concepts = w.parseConcepts()
+	w.takeSnapshots(w.parseSpecs())
+	w.execute(specDirs)
+	return w.watch()
+}
+
+func startRunner() runner.Runner {
+	sc := api.StartAPI(false)
+	select {
+	case r := <-sc.RunnerChan:
+		return r
+	case err := <-sc.ErrorChan:
+		logger.Fatalf(true, "Failed to start gauge API: %s", err.Error())
+	}
+	return nil
+}
+
+func (w *specWatcher) watch() int {
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.Errorf(true, "Error creating file watcher: %s", err.Error())
+		return ExecutionFailed
+	}
+	defer func() {
+		_ = fw.Close()
+	}()
+	w.addDirs(fw, config.ProjectRoot)
+	logger.Infof(true, "\nWatching for changes in %s. Press Ctrl+C to stop.", config.ProjectRoot)
+
+	changed := make(map[string]bool)
+	var delay <-chan time.Time
+	for {
+		select {
+		case e := <-fw.Events:
+			file, err := filepath.Abs(e.Name)
+			if err != nil {
+				logger.Errorf(false, "Failed to get abs file path for %s: %s", e.Name, err.Error())
+				continue
+			}
+			if e.Op&fsnotify.Create != 0 && util.IsDir(file) {
+				w.addDirs(fw, file)
+				continue
+			}
+			if e.Op == fsnotify.Chmod || util.IsDir(file) {
+				continue
+			}
+			changed[file] = true
+			delay = time.After(watchDelay)
+		case err := <-fw.Errors:
+			logger.Errorf(false, "Error event while watching project %s", err)
+		case <-delay:
+			files := changed
+			changed = make(map[string]bool)
+			delay = nil
+			if items := w.affectedItems(files); len(items) > 0 {
+				w.execute(items)
+				logger.Infof(true, "\nWatching for changes in %s. Press Ctrl+C to stop.", config.ProjectRoot)
+			}
+		}
+	}
+}
+
+func (w *specWatcher) addDirs(fw *fsnotify.Watcher, dir string) {
+	for _, d := range util.FindProjectDirs(dir) {
+		if err := fw.Add(d); err != nil {
+			logger.Errorf(false, "Unable to add directory %v to file watcher: %s", d, err.Error())
+		}
+	}
+}
+
+func (w *specWatcher) execute(items []string) int {
+	if !w.runner.Alive() {
+		if err := w.runner.restart(); err != nil {
+			logger.Errorf(true, "Failed to restart runner. %s", err.Error())
+			return ExecutionFailed
+		}
+	}
+	res := validation.ValidateSpecsWithRunner(items, &keepAliveRunner{w.runner})
+	if len(res.Errs) > 0 {
+		return ParseFailed
+	}
+	if res.SpecCollection.Size() < 1 {
+		logger.Infof(true, "No specifications found in %s.", strings.Join(items, ", "))
+		return Success
+	}
+	// the time budget applies to each run, as the watch goes on until it is stopped.
+	startTimeBudget()
+	return executeValidatedSpecs(res, items)
+}
+
+// affectedItems returns the specs and scenarios to be executed for the changed files.
+func (w *specWatcher) affectedItems(files map[string]bool) []string {
+	specFiles, conceptFiles, otherFiles := make(map[string]bool), make(map[string]bool), make(map[string]bool)
+	for f := range files {
+		switch {
+		case util.IsSpec(f):
+			specFiles[f] = true
+		case util.IsConcept(f):
+			conceptFiles[f] = true
+		default:
+			otherFiles[f] = true
+		}
+	}
+	steps := make(map[string]bool)
+	if len(conceptFiles) > 0 {
+		// concepts of the old dictionary are included, so that scenarios using removed or renamed concepts are executed too.
+		for v := range conceptsInFiles(w.concepts, conceptFiles) {
+			steps[v] = true
+		}
+		w.concepts = w.parseConcepts()
+		for v := range conceptsInFiles(w.concepts, conceptFiles) {
+			steps[v] = true
+		}
+	}
+	if implFiles := changedImplementationFiles(w.implFiles, otherFiles); len(implFiles) > 0 {
+		if err := w.runner.restart(); err != nil {
+			logger.Errorf(true, "Failed to restart runner. %s", err.Error())
+			return nil
+		}
+		if w.implFiles == nil {
+			logger.Infof(true, "Implementation changed, executing all specs.")
+			return w.specDirs
+		}
+		w.refreshImplementationFiles()
+		for _, f := range implFiles {
+			implSteps, err := implementedSteps(w.runner, f)
+			if err != nil {
+				logger.Debugf(true, "Unable to get step implementations in %s. %s", f, err.Error())
+				continue
+			}
+			for v := range implSteps {
+				steps[v] = true
+			}
+		}
+	}
+	specs := w.parseSpecs()
+	items := scenariosUsingSteps(specs, withDependentConcepts(w.concepts, steps))
+	items = append(items, w.changedScenarios(specs, specFiles)...)
+	w.takeSnapshots(specs)
+	return unique(items)
+}
+
+func (w *specWatcher) refreshImplementationFiles() {
+	files, err := implementationFiles(w.runner)
+	if err != nil {
+		logger.Debugf(true, "Unable to get implementation files from runner. %s", err.Error())
+		w.implFiles = nil
+		return
+	}
+	w.implFiles = files
+}
+
+func (w *specWatcher) parseConcepts() *gauge.ConceptDictionary {
+	dict, _, err := parser.ParseConcepts()
+	if err != nil {
+		logger.Errorf(true, "Unable to parse concepts: %s", err.Error())
+		if w.concepts != nil {
+			return w.concepts
+		}
+		return gauge.NewConceptDictionary()
+	}
+	return dict
+}
+
+func (w *specWatcher) parseSpecs() []*gauge.Specification {
+	var dirs []string
+	for _, d := range w.specDirs {
+		dirs = append(dirs, indexedSpec.ReplaceAllString(d, ""))
+	}
+	specs, _ := parser.ParseSpecFiles(util.GetSpecFiles(dirs), w.concepts, gauge.NewBuildErrors())
+	return specs
+}
+
+// specSnapshot holds the text of a spec, to find out which of its scenarios changed.
+type specSnapshot struct {
+	// spec is the text of the spec outside its scenarios, along with its contexts and teardowns.
+	spec      string
+	scenarios map[string]string
+}
+
+func (w *specWatcher) takeSnapshots(specs []*gauge.Specification) {
+	for _, spec := range specs {
+		if s, err := newSpecSnapshot(spec); err == nil {
+			w.snapshots[spec.FileName] = s
+		}
+	}
+}
+
+func newSpecSnapshot(spec *gauge.Specification) (*specSnapshot, error) {
+	content, err := common.ReadFileContents(spec.FileName)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(content, "\n")
+	inScenario := make([]bool, len(lines))
+	s := &specSnapshot{scenarios: make(map[string]string)}
+	for _, scn := range spec.Scenarios {
+		if scn.Span == nil || scn.Heading == nil {
+			continue
+		}
+		start, end := scn.Span.Start-1, scn.Span.End
+		if start < 0 || end > len(lines) || start >= end {
+			continue
+		}
+		for i := start; i < end; i++ {
+			inScenario[i] = true
+		}
+		s.scenarios[scn.Heading.Value] = strings.Join(lines[start:end], "\n")
+	}
+	var rest []string
+	for i, line := range lines {
+		if !inScenario[i] {
+			rest = append(rest, line)
+		}
+	}
+	for _, step := range append(append([]*gauge.Step{}, spec.Contexts...), spec.TearDownSteps...) {
+		rest = append(rest, step.LineText)
+	}
+	s.spec = strings.Join(rest, "\n")
+	return s, nil
+}
+
+// changedScenarios compares the changed specs with their last snapshot, and returns the changed scenarios.
+// A spec is returned as a whole if it is new, or if anything outside its scenarios changed.
+func (w *specWatcher) changedScenarios(specs []*gauge.Specification, files map[string]bool) (items []string) {
+	for _, spec := range specs {
+		if !files[spec.FileName] {
+			continue
+		}
+		old, ok := w.snapshots[spec.FileName]
+		current, err := newSpecSnapshot(spec)
+		if !ok || err != nil || old.spec != current.spec {
+			items = append(items, spec.FileName)
+			continue
+		}
+		for _, scn := range spec.Scenarios {
+			if scn.Heading == nil || scn.Span == nil {
+				continue
+			}
+			if text, ok := old.scenarios[scn.Heading.Value]; !ok || text != current.scenarios[scn.Heading.Value] {
+				items = append(items, scenarioRef(spec.FileName, scn))
+			}
+		}
+	}
+	return
+}
+
+// unique removes the duplicate items, and the scenarios of the specs which are to be executed as a whole.
+func unique(items []string) (result []string) {
+	wholeSpecs := make(map[string]bool)
+	for _, item := range items {
+		if !indexedSpec.MatchString(item) {
+			wholeSpecs[item] = true
+		}
+	}
+	seen := make(map[string]bool)
+	for _, item := range items {
+		if seen[item] || (indexedSpec.MatchString(item) && wholeSpecs[indexedSpec.ReplaceAllString(item, "")]) {
+			continue
+		}
+		seen[item] = true
+		result = append(result, item)
+	}
+	return
+}
@@ -0,0 +1,69 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func writeSpec(t *testing.T, file string, lines ...string) *gauge.Specification {
+	content := ""
+	for _, l := range lines {
+		content += l + "\n"
+	}
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return &gauge.Specification{FileName: file, Scenarios: []*gauge.Scenario{
+		{Heading: &gauge.Heading{Value: "First"}, Span: &gauge.Span{Start: 3, End: 5}},
+		{Heading: &gauge.Heading{Value: "Second"}, Span: &gauge.Span{Start: 6, End: 8}},
+	}}
+}
+
+func TestChangedScenariosOfAModifiedSpec(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "example.spec")
+	w := &specWatcher{snapshots: make(map[string]*specSnapshot)}
+	spec := writeSpec(t, file, "# Spec", "", "## First", "* step one", "", "## Second", "* step two", "")
+	w.takeSnapshots([]*gauge.Specification{spec})
+
+	spec = writeSpec(t, file, "# Spec", "", "## First", "* step one", "", "## Second", "* step three", "")
+	got := w.changedScenarios([]*gauge.Specification{spec}, map[string]bool{file: true})
+
+	want := []string{file + ":6"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
+
+func TestChangedScenariosWhenSpecHeaderChanges(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "example.spec")
+	w := &specWatcher{snapshots: make(map[string]*specSnapshot)}
+	spec := writeSpec(t, file, "# Spec", "", "## First", "* step one", "", "## Second", "* step two", "")
+	w.takeSnapshots([]*gauge.Specification{spec})
+
+	spec = writeSpec(t, file, "# Spec", "* context", "## First", "* step one", "", "## Second", "* step two", "")
+	got := w.changedScenarios([]*gauge.Specification{spec}, map[string]bool{file: true})
+
+	want := []string{file}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
+
+func TestUniqueDropsScenariosOfWholeSpecs(t *testing.T) {
+	got := unique([]string{"a.spec:3", "b.spec:4", "a.spec", "b.spec:4", "b.spec:9"})
+
+	want := []string{"b.spec:4", "a.spec", "b.spec:9"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
@@ -0,0 +1,115 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/validation"
+)
+
+// Work connects to the coordinator at the given address, and executes the specs handed out by it, using the same runner,
+// until all the specs are executed. The results are reported by the coordinator, so plugins are not started by the worker.
+func Work(address string) int {
+	if err := validateFlags(); err != nil {
+		logger.Fatal(true, err.Error())
+	}
+	setupExecution()
+	m, err := manifest.ProjectManifest()
+	if err != nil {
+		logger.Fatal(true, err.Error())
+	}
+	conn, err := net.Dial("tcp", address)
+	if err != nil {
+		logger.Fatalf(true, "Failed to connect to coordinator at %s. %s", address, err.Error())
+	}
+	w := newWorkerConn(conn)
+	defer w.close()
+	r := newRestartableRunner(startRunner(), m, 0)
+	defer func() {
+		if err := r.Kill(); err != nil {
+			logger.Errorf(true, "Failed to kill Runner: %s", err.Error())
+		}
+	}()
+	resetFailedScenariosCount()
+	resetRunnerRestartsCount()
+	event.InitRegistry()
+	wg := &sync.WaitGroup{}
+	reporter.ListenExecutionEvents(wg)
+	ei := newExecutionInfo(gauge.NewSpecCollection(nil, false), r, &plugin.GaugePlugins{}, gauge.NewBuildErrors(), false, 0)
+	e := newSimpleExecution(ei, false, true)
+	e.suiteResult = result.NewSuiteResult(ExecuteTags, time.Now())
+	logger.Infof(true, "Connected to coordinator at %s.", address)
+	if err := work(w, e, r); err != nil {
+		logger.Errorf(true, "Lost connection to coordinator at %s. %s", address, err.Error())
+		return ExecutionFailed
+	}
+	return Success
+}
+
+// work executes the specs handed out by the coordinator, between the suite hooks.
+func work(w *workerConn, e *simpleExecution, r *restartableRunner) error {
+	if res := e.initSuiteDataStore(); res.GetFailed() {
+		logger.Errorf(true, "Failed to initialize suite datastore. Error: %s", res.GetErrorMessage())
+	}
+	e.notifyBeforeSuite()
+	defer e.notifyAfterSuite()
+	if err := w.send(&workerMessage{Kind: nextMessage, Token: workerToken()}); err != nil {
+		return err
+	}
+	for {
+		m, err := w.receive()
+		if err == io.EOF || (err == nil && m.Kind == doneMessage) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !r.Alive() {
+			if err := r.restart(); err != nil {
+				return err
+			}
+		}
+		entries, err := resultEntries(executeItems(e, r, m))
+		if err != nil {
+			return err
+		}
+		if err := w.send(&workerMessage{Kind: resultsMessage, Results: entries}); err != nil {
+			return err
+		}
+	}
+}
+
+// executeItems executes the specs and scenarios handed out by the coordinator, with the coordinator's settings.
+func executeItems(e *simpleExecution, r *restartableRunner, m *workerMessage) []*result.SpecResult {
+	if s := m.Settings; s != nil {
+		MaxRetriesCount = s.MaxRetriesCount
+		RetryOnlyTags = s.RetryOnlyTags
+		SetTableRows(s.TableRows)
+		validation.TableRows = s.TableRows
+	}
+	items := projectItems(m.Specs)
+	res := validation.ValidateSpecsWithRunner(items, &keepAliveRunner{r})
+	if len(res.Errs) > 0 {
+		logger.Errorf(true, "Failed to validate %s.", strings.Join(m.Specs, ", "))
+	}
+	e.errMaps = res.ErrMap
+	e.specCollection = gauge.NewSpecCollection(res.SpecCollection.Specs(), true)
+	expectSpecs(e.specCollection.Specs())
+	return e.executeSpecs(e.specCollection)
+}
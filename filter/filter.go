@@ -11,6 +11,11 @@ var Distribute int
 var NumberOfExecutionStreams int
 var ScenariosName []string
 
+// GroupDurations holds the execution time in milliseconds of the specs, keyed by the spec path relative to the project root,
+// to distribute the specs among the groups of the -g flag. The specs are distributed by count when it is nil, as the timings
+// recorded on each machine differ and every machine running a group has to arrive at the same distribution.
+var GroupDurations map[string]int64
+
 func FilterSpecs(specs []*gauge.Specification) []*gauge.Specification {
 	specs = applyFilters(specs, specsFilters())
 	if ExecuteTags != "" && len(specs) > 0 {
@@ -7,10 +7,12 @@
 package filter
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
 )
 
 type specsFilter interface {
@@ -61,7 +63,7 @@ func (groupFilter *specsGroupFilter) filter(specs []*gauge.Specification) []*gau
 		return make([]*gauge.Specification, 0)
 	}
 	logger.Debugf(true, "Applying group filter: %d", groupFilter.group)
-	group := DistributeSpecs(specs, groupFilter.execStreams)[groupFilter.group-1]
+	group := DistributeSpecs(specs, groupFilter.execStreams, GroupDurations)[groupFilter.group-1]
 	if group == nil {
 		return make([]*gauge.Specification, 0)
 	}
@@ -76,14 +78,67 @@ func (scenarioFilter *scenariosFilter) filter(specs []*gauge.Specification) []*g
 	return specs
 }
 
-func DistributeSpecs(specifications []*gauge.Specification, distributions int) []*gauge.SpecCollection {
+// DistributeSpecs splits the specifications into the given number of groups.
+// Specs with execution times in the given durations are packed so that the groups take about the same time,
+// longest spec first. The remaining specs are distributed round robin.
+// The durations are in milliseconds, keyed by the spec path relative to the project root, and may be nil.
+// The relative order of the specs is retained within each group.
+func DistributeSpecs(specifications []*gauge.Specification, distributions int, durations map[string]int64) []*gauge.SpecCollection {
 	s := make([]*gauge.SpecCollection, distributions)
-	for i := 0; i < len(specifications); i++ {
-		mod := i % distributions
-		if s[mod] == nil {
-			s[mod] = gauge.NewSpecCollection(make([]*gauge.Specification, 0), false)
+	groups := assignGroups(specifications, distributions, specDurations(specifications, durations))
+	for i, spec := range specifications {
+		g := groups[i]
+		if s[g] == nil {
+			s[g] = gauge.NewSpecCollection(make([]*gauge.Specification, 0), false)
 		}
-		s[mod].Add(specifications[i])
+		s[g].Add(spec)
 	}
 	return s
 }
+
+func assignGroups(specifications []*gauge.Specification, distributions int, durations []int64) []int {
+	groups := make([]int, len(specifications))
+	var known, unknown []int
+	for i := range specifications {
+		if durations[i] < 0 {
+			unknown = append(unknown, i)
+		} else {
+			known = append(known, i)
+		}
+	}
+	sort.SliceStable(known, func(i, j int) bool {
+		return durations[known[i]] > durations[known[j]]
+	})
+	loads := make([]int64, distributions)
+	for _, i := range known {
+		g := 0
+		for j := range loads {
+			if loads[j] < loads[g] {
+				g = j
+			}
+		}
+		groups[i] = g
+		loads[g] += durations[i]
+	}
+	for n, i := range unknown {
+		groups[i] = n % distributions
+	}
+	return groups
+}
+
+// specDurations returns the execution time of each spec as per the given durations, or -1 if there is none.
+// The time of a spec file is shared equally by its data table rows, which are executed as separate specs.
+func specDurations(specifications []*gauge.Specification, recorded map[string]int64) []int64 {
+	durations := make([]int64, len(specifications))
+	rows := make(map[string]int64)
+	for _, spec := range specifications {
+		rows[spec.FileName]++
+	}
+	for i, spec := range specifications {
+		durations[i] = -1
+		if d, ok := recorded[util.RelPathToProjectRoot(spec.FileName)]; ok {
+			durations[i] = d / rows[spec.FileName]
+		}
+	}
+	return durations
+}
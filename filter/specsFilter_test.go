@@ -15,29 +15,58 @@ import (
 
 func (s *MySuite) TestDistributionOfSpecs(c *C) {
 	specs := createSpecsList(10)
-	specCollections := DistributeSpecs(specs, 10)
+	specCollections := DistributeSpecs(specs, 10, nil)
 	c.Assert(len(specCollections), Equals, 10)
 	verifySpecCollectionsForSize(c, 1, specCollections...)
 
-	specCollections = DistributeSpecs(specs, 5)
+	specCollections = DistributeSpecs(specs, 5, nil)
 	c.Assert(len(specCollections), Equals, 5)
 	verifySpecCollectionsForSize(c, 2, specCollections...)
 
-	specCollections = DistributeSpecs(specs, 4)
+	specCollections = DistributeSpecs(specs, 4, nil)
 	c.Assert(len(specCollections), Equals, 4)
 	verifySpecCollectionsForSize(c, 3, specCollections[:2]...)
 	verifySpecCollectionsForSize(c, 2, specCollections[2:]...)
 
-	specCollections = DistributeSpecs(specs, 3)
+	specCollections = DistributeSpecs(specs, 3, nil)
 	c.Assert(len(specCollections), Equals, 3)
 	verifySpecCollectionsForSize(c, 4, specCollections[0])
 	verifySpecCollectionsForSize(c, 3, specCollections[1:]...)
 
 	specs = createSpecsList(0)
-	specCollections = DistributeSpecs(specs, 0)
+	specCollections = DistributeSpecs(specs, 0, nil)
 	c.Assert(len(specCollections), Equals, 0)
 }
 
+func (s *MySuite) TestDistributionOfSpecsUsingRecordedDurations(c *C) {
+	durations := map[string]int64{"spec0": 10, "spec1": 60, "spec2": 30, "spec3": 40, "spec4": 20}
+	specs := createSpecsList(6)
+
+	specCollections := DistributeSpecs(specs, 2, durations)
+
+	c.Assert(len(specCollections), Equals, 2)
+	c.Assert(specFileNames(specCollections[0]), DeepEquals, []string{"spec1", "spec4", "spec5"})
+	c.Assert(specFileNames(specCollections[1]), DeepEquals, []string{"spec0", "spec2", "spec3"})
+}
+
+func (s *MySuite) TestDistributionOfDataTableRowsUsingRecordedDurations(c *C) {
+	durations := map[string]int64{"table": 90, "spec": 50}
+	specs := []*gauge.Specification{{FileName: "table"}, {FileName: "table"}, {FileName: "table"}, {FileName: "spec"}}
+
+	specCollections := DistributeSpecs(specs, 2, durations)
+
+	c.Assert(specFileNames(specCollections[0]), DeepEquals, []string{"table", "spec"})
+	c.Assert(specFileNames(specCollections[1]), DeepEquals, []string{"table", "table"})
+}
+
+func specFileNames(collection *gauge.SpecCollection) []string {
+	var names []string
+	for _, spec := range collection.Specs() {
+		names = append(names, spec.FileName)
+	}
+	return names
+}
+
 func verifySpecCollectionsForSize(c *C, size int, specCollections ...*gauge.SpecCollection) {
 	for _, collection := range specCollections {
 		c.Assert(len(collection.Specs()), Equals, size)
@@ -114,6 +143,16 @@ func (s *MySuite) TestToRunSpecificSetOfSpecsGivesSameSpecsEverytime(c *C) {
 	c.Assert(specsToExecute3[1].Heading, Equals, specsToExecute1[1].Heading)
 }
 
+func (s *MySuite) TestToRunSpecificSetOfSpecsUsingGroupDurations(c *C) {
+	GroupDurations = map[string]int64{"spec0": 10, "spec1": 60, "spec2": 30, "spec3": 40}
+	defer func() { GroupDurations = nil }()
+	specs := createSpecsList(4)
+
+	groupFilter := &specsGroupFilter{1, 2}
+
+	c.Assert(groupFilter.filter(specs), DeepEquals, []*gauge.Specification{specs[0], specs[1]})
+}
+
 func (s *MySuite) TestToRunNonExistingSpecificSetOfSpecs(c *C) {
 	spec1 := &gauge.Specification{Heading: &gauge.Heading{Value: "SPECHEADING1"}}
 	var specs []*gauge.Specification
@@ -15,6 +15,7 @@ import (
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
@@ -100,7 +101,7 @@ func FormatStepWithResolvedArgs(step *gauge.Step) string {
 		if a.ArgType == gauge.TableArg && sf[i].Parameter.ParameterType == gauge_messages.Parameter_Table {
 			formattedArg = fmt.Sprintf("\n%s", FormatTable(&a.Table))
 		} else {
-			formattedArg = fmt.Sprintf("\"%s\"", sf[i].GetParameter().Value)
+			formattedArg = fmt.Sprintf("\"%s\"", env.MaskSecret(sf[i].GetParameter().Value))
 		}
 		text = strings.Replace(text, gauge.ParameterPlaceholder, formattedArg, 1)
 	}
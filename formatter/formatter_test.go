@@ -7,6 +7,7 @@
 package formatter
 
 import (
+	"os"
 	"testing"
 
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
@@ -823,4 +824,22 @@ We can show code examples here.
 	if got != want {
 		t.Errorf("unexpected formatted step.\nGot:\n%q\nWant:\n%q", got, want)
 	}
-}
\ No newline at end of file
+}
+func (s *MySuite) TestFormatStepsWithResolveArgsMasksSecrets(c *C) {
+	c.Assert(os.Setenv("gauge_secret_keys", "password"), IsNil)
+	c.Assert(os.Setenv("password", "s3cret"), IsNil)
+	defer func() {
+		_ = os.Unsetenv("gauge_secret_keys")
+		_ = os.Unsetenv("password")
+	}()
+	step := &gauge.Step{Value: "login as {} with {}", Args: []*gauge.StepArg{&gauge.StepArg{Value: "alice", ArgType: gauge.Static},
+		&gauge.StepArg{Name: "env:password", Value: "s3cret", ArgType: gauge.SpecialString}},
+		Fragments: []*gauge_messages.Fragment{
+			&gauge_messages.Fragment{Text: "login as "},
+			&gauge_messages.Fragment{FragmentType: gauge_messages.Fragment_Parameter, Parameter: &gauge_messages.Parameter{Value: "alice", ParameterType: gauge_messages.Parameter_Static}},
+			&gauge_messages.Fragment{Text: " with "},
+			&gauge_messages.Fragment{FragmentType: gauge_messages.Fragment_Parameter, Parameter: &gauge_messages.Parameter{Value: "s3cret", ParameterType: gauge_messages.Parameter_Special_String}}}}
+	formatted := FormatStepWithResolvedArgs(step)
+	c.Assert(formatted, Equals, `* login as "alice" with "******"
+`)
+}
@@ -14,6 +14,11 @@ type SpecCollection struct {
 	mutex sync.Mutex
 	index int
 	specs [][]*Specification
+	// taken holds the indexes of the specs after index, which were taken out of order as the specs before them were locked.
+	taken map[int]bool
+	// locks holds the resources locked by the specs in execution.
+	locks    map[string]bool
+	released *sync.Cond
 }
 
 func NewSpecCollection(s []*Specification, groupDataTableSpecs bool) *SpecCollection {
@@ -58,12 +63,90 @@ func (s *SpecCollection) HasNext() bool {
 	return s.index < len(s.specs)
 }
 
+// Next returns the next specs, whose locks are not held by the specs in execution, and takes their locks.
+// It waits for the locks to be released by Done if all the remaining specs are locked, and returns nil if no specs remain.
 func (s *SpecCollection) Next() []*Specification {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
-	spec := s.specs[s.index]
-	s.index++
-	return spec
+	for {
+		if specs := s.takeNext(nil); specs != nil {
+			return specs
+		}
+		if s.index >= len(s.specs) {
+			return nil
+		}
+		s.waitForRelease()
+	}
+}
+
+// TryNext returns the next specs like Next, which are also ready to be executed as per the given function.
+// It returns nil instead of waiting if all the remaining specs are locked or not ready.
+func (s *SpecCollection) TryNext(ready func([]*Specification) bool) []*Specification {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.takeNext(ready)
+}
+
+func (s *SpecCollection) takeNext(ready func([]*Specification) bool) []*Specification {
+	for i := s.index; i < len(s.specs); i++ {
+		if s.taken[i] || s.isLocked(s.specs[i]) || (ready != nil && !ready(s.specs[i])) {
+			continue
+		}
+		s.take(i)
+		return s.specs[i]
+	}
+	return nil
+}
+
+// Done releases the locks of the specs returned by Next, once they are executed.
+func (s *SpecCollection) Done(specs []*Specification) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, spec := range specs {
+		for _, l := range spec.Locks() {
+			delete(s.locks, l)
+		}
+	}
+	if s.released != nil {
+		s.released.Broadcast()
+	}
+}
+
+func (s *SpecCollection) isLocked(specs []*Specification) bool {
+	for _, spec := range specs {
+		for _, l := range spec.Locks() {
+			if s.locks[l] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *SpecCollection) take(i int) {
+	if s.taken == nil {
+		s.taken = make(map[int]bool)
+	}
+	if s.locks == nil {
+		s.locks = make(map[string]bool)
+	}
+	s.taken[i] = true
+	for _, spec := range s.specs[i] {
+		for _, l := range spec.Locks() {
+			s.locks[l] = true
+		}
+	}
+	for s.taken[s.index] {
+		delete(s.taken, s.index)
+		s.index++
+	}
+}
+
+func (s *SpecCollection) waitForRelease() {
+	if s.released == nil {
+		s.released = sync.NewCond(&s.mutex)
+	}
+	s.released.Wait()
 }
 
 func (s *SpecCollection) Size() int {
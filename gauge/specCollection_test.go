@@ -9,6 +9,7 @@ package gauge
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestSpecCollection(t *testing.T) {
@@ -53,3 +54,77 @@ func set(s [][]*Specification) map[int][]*Specification {
 	}
 	return specs
 }
+
+func lockedSpec(name string, locks ...string) *Specification {
+	tags := &Tags{}
+	for _, l := range locks {
+		tags.Add([]string{LockTagPrefix + l})
+	}
+	return &Specification{FileName: name, Tags: tags}
+}
+
+func TestSpecCollectionSkipsLockedSpecs(t *testing.T) {
+	s1 := lockedSpec("filename1", "db")
+	s2 := lockedSpec("filename2", "db")
+	s3 := lockedSpec("filename3")
+
+	collection := NewSpecCollection([]*Specification{s1, s2, s3}, false)
+
+	got := [][]*Specification{collection.Next(), collection.Next()}
+	want := [][]*Specification{{s1}, {s3}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected spec holding a taken lock to be skipped\n\tWant: %v\n\t Got:%v", want, got)
+	}
+
+	next := make(chan []*Specification)
+	go func() {
+		next <- collection.Next()
+	}()
+	select {
+	case specs := <-next:
+		t.Fatalf("Expected to wait for the lock to be released. Got %v", specs)
+	case <-time.After(50 * time.Millisecond):
+	}
+	collection.Done(got[0])
+	if specs := <-next; !reflect.DeepEqual(specs, []*Specification{s2}) {
+		t.Errorf("Expected spec to be returned once the lock is released. Got %v", specs)
+	}
+	if collection.HasNext() || collection.Next() != nil {
+		t.Errorf("Expected no more specs")
+	}
+}
+
+func TestSpecCollectionTryNextDoesNotWaitForLocks(t *testing.T) {
+	s1 := lockedSpec("filename1", "db")
+	s2 := lockedSpec("filename2", "db")
+
+	collection := NewSpecCollection([]*Specification{s1, s2}, false)
+
+	if specs := collection.TryNext(nil); !reflect.DeepEqual(specs, []*Specification{s1}) {
+		t.Errorf("Expected first spec. Got %v", specs)
+	}
+	if specs := collection.TryNext(nil); specs != nil {
+		t.Errorf("Expected no spec while the lock is taken. Got %v", specs)
+	}
+	collection.Done([]*Specification{s1})
+	if specs := collection.TryNext(nil); !reflect.DeepEqual(specs, []*Specification{s2}) {
+		t.Errorf("Expected spec to be returned once the lock is released. Got %v", specs)
+	}
+}
+
+func TestSpecCollectionTryNextSkipsSpecsWhichAreNotReady(t *testing.T) {
+	s1 := lockedSpec("filename1")
+	s2 := lockedSpec("filename2")
+	collection := NewSpecCollection([]*Specification{s1, s2}, false)
+	ready := func(specs []*Specification) bool { return specs[0] != s1 }
+
+	if specs := collection.TryNext(ready); !reflect.DeepEqual(specs, []*Specification{s2}) {
+		t.Errorf("Expected the spec which is ready. Got %v", specs)
+	}
+	if specs := collection.TryNext(ready); specs != nil {
+		t.Errorf("Expected no spec while the remaining spec is not ready. Got %v", specs)
+	}
+	if !collection.HasNext() {
+		t.Errorf("Expected the spec which is not ready to remain")
+	}
+}
@@ -7,9 +7,17 @@
 package gauge
 
 import (
+	"path/filepath"
 	"reflect"
+	"strings"
 )
 
+// LockTagPrefix is the prefix of the tags declaring the resources locked by a spec or a scenario.
+const LockTagPrefix = "lock:"
+
+// DependsOnTagPrefix is the prefix of the tags declaring the specs to be executed before a spec.
+const DependsOnTagPrefix = "depends-on:"
+
 type HeadingType int
 
 const (
@@ -158,6 +166,52 @@ func (spec *Specification) NTags() int {
 	return len(spec.Tags.Values())
 }
 
+// Locks returns the names of the resources locked by the spec and its scenarios, declared through tags like lock:<name>.
+// Specs holding the same lock are not executed at the same time by the parallel streams.
+func (spec *Specification) Locks() (locks []string) {
+	tags := tagValues(spec.Tags)
+	for _, scn := range spec.Scenarios {
+		tags = append(tags, tagValues(scn.Tags)...)
+	}
+	for _, t := range tags {
+		if name := strings.TrimSpace(t); strings.HasPrefix(name, LockTagPrefix) {
+			locks = append(locks, strings.TrimSpace(strings.TrimPrefix(name, LockTagPrefix)))
+		}
+	}
+	return locks
+}
+
+// Dependencies returns the paths of the specs which the spec depends on, declared through tags like depends-on:<spec path>.
+func (spec *Specification) Dependencies() (paths []string) {
+	for _, t := range tagValues(spec.Tags) {
+		if name := strings.TrimSpace(t); strings.HasPrefix(name, DependsOnTagPrefix) {
+			paths = append(paths, filepath.Clean(filepath.FromSlash(strings.TrimSpace(strings.TrimPrefix(name, DependsOnTagPrefix)))))
+		}
+	}
+	return paths
+}
+
+// DependsOn tells if the spec depends on the given spec file, i.e. if the file path ends with one of its dependencies.
+func (spec *Specification) DependsOn(fileName string) bool {
+	fileName = filepath.Clean(fileName)
+	if fileName == filepath.Clean(spec.FileName) {
+		return false
+	}
+	for _, d := range spec.Dependencies() {
+		if fileName == d || strings.HasSuffix(fileName, string(filepath.Separator)+d) {
+			return true
+		}
+	}
+	return false
+}
+
+func tagValues(tags *Tags) []string {
+	if tags == nil {
+		return nil
+	}
+	return tags.Values()
+}
+
 func (spec *Specification) LatestScenario() *Scenario {
 	return spec.Scenarios[len(spec.Scenarios)-1]
 }
@@ -111,3 +111,23 @@ func (s *MySuite) TestStepsGiveAllStepsPresentInSpec(c *C) {
 
 	c.Assert(spec.Steps(), DeepEquals, []*Step{step1, step2, step3})
 }
+
+func (s *MySuite) TestLocksOfSpecAndScenarios(c *C) {
+	spec := &Specification{
+		Tags: &Tags{RawValues: [][]string{{"smoke", "lock:payments-db"}}},
+		Scenarios: []*Scenario{
+			{Tags: &Tags{RawValues: [][]string{{" lock:mail-server"}}}},
+			{},
+		},
+	}
+
+	c.Assert(spec.Locks(), DeepEquals, []string{"payments-db", "mail-server"})
+}
+
+func (s *MySuite) TestDependsOnMatchesTheEndOfSpecPath(c *C) {
+	spec := &Specification{FileName: "/project/specs/order.spec", Tags: &Tags{RawValues: [][]string{{"depends-on:accounts/setup.spec"}}}}
+
+	c.Assert(spec.DependsOn("/project/specs/accounts/setup.spec"), Equals, true)
+	c.Assert(spec.DependsOn("/project/specs/admin/setup.spec"), Equals, false)
+	c.Assert(spec.DependsOn("/project/specs/old_accounts/setup.spec"), Equals, false)
+}
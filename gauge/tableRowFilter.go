@@ -0,0 +1,133 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TableRowFilter selects the rows of data tables by the values of their columns, with an expression like "country=IN && tier!=gold".
+// Conditions are compared with = or !=, and combined with && and ||, where && takes precedence.
+type TableRowFilter struct {
+	// anyOf holds the alternatives separated by ||, each of which is satisfied if all its conditions are.
+	anyOf [][]columnCondition
+}
+
+type columnCondition struct {
+	column string
+	value  string
+	negate bool
+}
+
+// DataTableRow is a row of a data table.
+type DataTableRow struct {
+	Table *Table
+	Index int
+}
+
+// IsTableRowFilter tells if the table rows are given as a filter on column values, rather than as row numbers.
+func IsTableRowFilter(tableRows string) bool {
+	return strings.Contains(tableRows, "=")
+}
+
+// ParseTableRowFilter parses a filter expression on column values of data table rows.
+func ParseTableRowFilter(expr string) (*TableRowFilter, error) {
+	f := &TableRowFilter{}
+	for _, alternative := range strings.Split(expr, "||") {
+		var conditions []columnCondition
+		for _, c := range strings.Split(alternative, "&&") {
+			condition, err := parseColumnCondition(c)
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, condition)
+		}
+		f.anyOf = append(f.anyOf, conditions)
+	}
+	return f, nil
+}
+
+func parseColumnCondition(c string) (columnCondition, error) {
+	c = strings.TrimSpace(c)
+	i := strings.Index(c, "=")
+	if i < 0 {
+		return columnCondition{}, fmt.Errorf("condition '%s' should be of format column=value or column!=value", c)
+	}
+	condition := columnCondition{column: c[:i], value: strings.TrimSpace(c[i+1:])}
+	if strings.HasSuffix(condition.column, "!") {
+		condition.column, condition.negate = strings.TrimSuffix(condition.column, "!"), true
+	}
+	// == is accepted as well, as it is how equality is written in most languages.
+	condition.value = strings.TrimSpace(strings.TrimPrefix(condition.value, "="))
+	if condition.column = strings.TrimSpace(condition.column); condition.column == "" {
+		return columnCondition{}, fmt.Errorf("condition '%s' has no column name", c)
+	}
+	return condition, nil
+}
+
+// Matches tells if the rows, taken together as one row, satisfy the filter. A column is looked up in the rows in order.
+// A condition on a column which none of the rows has is satisfied, so that tables without the column are not filtered.
+// The columns which no data table of the specs has are reported when validating the specs, see UnknownColumns.
+func (f *TableRowFilter) Matches(rows ...DataTableRow) bool {
+	for _, conditions := range f.anyOf {
+		if matchesAll(conditions, rows) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAll(conditions []columnCondition, rows []DataTableRow) bool {
+	for _, c := range conditions {
+		value, ok := columnValue(c.column, rows)
+		if ok && (value == c.value) == c.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// UnknownColumns gives the columns of the filter which none of the tables has, in the order they appear in the filter.
+func (f *TableRowFilter) UnknownColumns(tables ...*Table) []string {
+	var unknown []string
+	seen := make(map[string]bool)
+	for _, conditions := range f.anyOf {
+		for _, c := range conditions {
+			if seen[c.column] {
+				continue
+			}
+			seen[c.column] = true
+			if !hasColumn(c.column, tables) {
+				unknown = append(unknown, c.column)
+			}
+		}
+	}
+	return unknown
+}
+
+func hasColumn(column string, tables []*Table) bool {
+	for _, t := range tables {
+		if t.IsInitialized() && t.headerExists(column) {
+			return true
+		}
+	}
+	return false
+}
+
+func columnValue(column string, rows []DataTableRow) (string, bool) {
+	for _, r := range rows {
+		if !r.Table.IsInitialized() || !r.Table.headerExists(column) {
+			continue
+		}
+		cells, _ := r.Table.Get(column)
+		if r.Index < len(cells) {
+			return strings.TrimSpace(cells[r.Index].Value), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,80 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func oneRowTable(headers []string, values ...string) *Table {
+	var cols [][]TableCell
+	for _, v := range values {
+		cols = append(cols, []TableCell{{Value: v, CellType: Static}})
+	}
+	return NewTable(headers, cols, 0)
+}
+
+func TestTableRowFilterMatchesColumnValues(t *testing.T) {
+	f, err := ParseTableRowFilter("country=IN && tier!=gold || country==US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers := []string{"country", "tier"}
+	tests := []struct {
+		country, tier string
+		want          bool
+	}{
+		{"IN", "silver", true},
+		{"IN", "gold", false},
+		{"UK", "silver", false},
+		{"US", "gold", true},
+	}
+	for _, test := range tests {
+		row := DataTableRow{Table: oneRowTable(headers, test.country, test.tier)}
+		if got := f.Matches(row); got != test.want {
+			t.Errorf("Expected %s/%s to match: %v. Got %v", test.country, test.tier, test.want, got)
+		}
+	}
+}
+
+func TestTableRowFilterLooksUpColumnsAcrossTables(t *testing.T) {
+	f, err := ParseTableRowFilter("country=IN && browser=chrome")
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := DataTableRow{Table: oneRowTable([]string{"country"}, "IN")}
+
+	if !f.Matches(DataTableRow{Table: oneRowTable([]string{"browser"}, "chrome")}, spec) {
+		t.Errorf("Expected row of scenario and spec tables to match")
+	}
+	if f.Matches(DataTableRow{Table: oneRowTable([]string{"browser"}, "firefox")}, spec) {
+		t.Errorf("Expected scenario table row not to match")
+	}
+	if !f.Matches(DataTableRow{Table: &Table{}}, spec) {
+		t.Errorf("Expected condition on a column missing from the tables to be satisfied")
+	}
+}
+
+func TestTableRowFilterGivesColumnsNoTableHas(t *testing.T) {
+	f, err := ParseTableRowFilter("country=IN && tier!=gold || browser=chrome && country=US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := f.UnknownColumns(oneRowTable([]string{"country"}, "IN"), &Table{})
+
+	if !reflect.DeepEqual(got, []string{"tier", "browser"}) {
+		t.Errorf("Expected tier and browser to be unknown columns, got %v", got)
+	}
+}
+
+func TestIsTableRowFilter(t *testing.T) {
+	if IsTableRowFilter("2-4") || IsTableRowFilter("2,4") || !IsTableRowFilter("tier!=gold") {
+		t.Errorf("Expected only expressions on column values to be filters")
+	}
+}
@@ -43,11 +43,21 @@ func Warningf(stdout bool, msg string, args ...interface{}) {
 	Warning(stdout, fmt.Sprintf(msg, args...))
 }
 
+var fatalHandlers []func()
+
+// OnFatal registers a function to be called before gauge exits on a fatal error, like killing the processes it started.
+func OnFatal(f func()) {
+	fatalHandlers = append(fatalHandlers, f)
+}
+
 // Fatal logs CRITICAL messages and exits. stdout flag indicates if message is to be written to stdout in addition to log.
 func Fatal(stdout bool, msg string) {
 	logCritical(loggersMap.getLogger(gaugeModuleID), msg)
 	addFatalError(gaugeModuleID, msg)
 	write(stdout, getFatalErrorMsg(), os.Stdout)
+	for _, f := range fatalHandlers {
+		f()
+	}
 	os.Exit(1)
 }
 
@@ -20,6 +20,7 @@ import (
 
 type Manifest struct {
 	Language       string
+	Languages      []string `json:",omitempty"`
 	Plugins        []string
 	EnvironmentDir string
 }
@@ -39,10 +40,29 @@ func ProjectManifest() (*Manifest, error) {
 			return nil, fmt.Errorf("Failed to read Manifest. %s\n", err.Error())
 		}
 	}
-
+	if m.Language == "" && len(m.Languages) > 0 {
+		m.Language = m.Languages[0]
+	}
 	return &m, nil
 }
 
+// AllLanguages gives the languages of the runners of the project. Language is the first of them when
+// the steps are implemented in several languages.
+func (m *Manifest) AllLanguages() []string {
+	if len(m.Languages) == 0 {
+		return []string{m.Language}
+	}
+	return m.Languages
+}
+
+// ForLanguage gives the manifest of the project as seen by the runner of the given language.
+func (m *Manifest) ForLanguage(language string) *Manifest {
+	lm := *m
+	lm.Language = language
+	lm.Languages = nil
+	return &lm
+}
+
 func (m *Manifest) Save() error {
 	b, err := json.MarshalIndent(m, "", "  ")
 	if err != nil {
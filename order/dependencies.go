@@ -0,0 +1,166 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package order
+
+import (
+	"github.com/getgauge/gauge/gauge"
+)
+
+// dependencyGraph holds, for every spec, the indexes of the other specs it depends on.
+type dependencyGraph [][]int
+
+func newDependencyGraph(specs []*gauge.Specification) (dependencyGraph, bool) {
+	g := make(dependencyGraph, len(specs))
+	found := false
+	for i, spec := range specs {
+		if len(spec.Dependencies()) == 0 {
+			continue
+		}
+		for j, other := range specs {
+			if i != j && spec.DependsOn(other.FileName) {
+				g[i] = append(g[i], j)
+				found = true
+			}
+		}
+	}
+	return g, found
+}
+
+// components returns the strongly connected component of every spec. Specs in the same component form a dependency cycle.
+func (g dependencyGraph) components() []int {
+	index, low, component := make([]int, len(g)), make([]int, len(g)), make([]int, len(g))
+	onStack := make([]bool, len(g))
+	for i := range index {
+		index[i] = -1
+	}
+	var stack []int
+	next, count := 0, 0
+	var visit func(int)
+	visit = func(v int) {
+		index[v], low[v] = next, next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range g[v] {
+			if index[w] < 0 {
+				visit(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], index[w])
+			}
+		}
+		if low[v] == index[v] {
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component[w] = count
+				if w == v {
+					break
+				}
+			}
+			count++
+		}
+	}
+	for v := range g {
+		if index[v] < 0 {
+			visit(v)
+		}
+	}
+	return component
+}
+
+// sortByDependencies moves every spec after the specs it depends on, keeping the order of the specs otherwise.
+// Specs in a dependency cycle, and the specs depending on them, are left at the end in their order.
+func sortByDependencies(specs []*gauge.Specification) []*gauge.Specification {
+	g, found := newDependencyGraph(specs)
+	if !found {
+		return specs
+	}
+	placed := make([]bool, len(specs))
+	sorted := make([]*gauge.Specification, 0, len(specs))
+	for len(sorted) < len(specs) {
+		next := -1
+		for i := range specs {
+			if !placed[i] && allPlaced(g[i], placed) {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			for i, spec := range specs {
+				if !placed[i] {
+					sorted = append(sorted, spec)
+				}
+			}
+			break
+		}
+		placed[next] = true
+		sorted = append(sorted, specs[next])
+	}
+	return sorted
+}
+
+func allPlaced(indexes []int, placed []bool) bool {
+	for _, i := range indexes {
+		if !placed[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DependencyCycles returns the groups of specs which depend on each other.
+func DependencyCycles(specs []*gauge.Specification) (cycles [][]*gauge.Specification) {
+	g, found := newDependencyGraph(specs)
+	if !found {
+		return nil
+	}
+	component := g.components()
+	members := make(map[int][]*gauge.Specification)
+	var order []int
+	for i, c := range component {
+		if _, ok := members[c]; !ok {
+			order = append(order, c)
+		}
+		members[c] = append(members[c], specs[i])
+	}
+	for _, c := range order {
+		if len(members[c]) > 1 {
+			cycles = append(cycles, members[c])
+		}
+	}
+	return cycles
+}
+
+// Prerequisites returns the files of the specs which each spec file depends on.
+// Dependencies between the specs of a cycle are left out, as such specs cannot be executed one after the other.
+func Prerequisites(specs []*gauge.Specification) map[string][]string {
+	g, found := newDependencyGraph(specs)
+	if !found {
+		return nil
+	}
+	component := g.components()
+	prerequisites := make(map[string][]string)
+	for i, deps := range g {
+		for _, j := range deps {
+			if component[i] != component[j] && !contains(prerequisites[specs[i].FileName], specs[j].FileName) {
+				prerequisites[specs[i].FileName] = append(prerequisites[specs[i].FileName], specs[j].FileName)
+			}
+		}
+	}
+	return prerequisites
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package order
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func specDependingOn(fileName string, dependencies ...string) *gauge.Specification {
+	tags := &gauge.Tags{}
+	for _, d := range dependencies {
+		tags.Add([]string{gauge.DependsOnTagPrefix + d})
+	}
+	return &gauge.Specification{FileName: fileName, Tags: tags}
+}
+
+func fileNames(specs []*gauge.Specification) (names []string) {
+	for _, s := range specs {
+		names = append(names, s.FileName)
+	}
+	return
+}
+
+func TestSortMovesSpecsAfterTheirDependencies(t *testing.T) {
+	specs := []*gauge.Specification{
+		specDependingOn("/project/specs/order.spec", "setup_accounts.spec"),
+		specDependingOn("/project/specs/search.spec"),
+		specDependingOn("/project/specs/setup_accounts.spec", "specs/login.spec"),
+		specDependingOn("/project/specs/login.spec"),
+	}
+
+	SortOrder = ""
+	got := fileNames(Sort(specs))
+
+	want := []string{"/project/specs/search.spec", "/project/specs/login.spec", "/project/specs/setup_accounts.spec", "/project/specs/order.spec"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
+
+func TestDependencyCycles(t *testing.T) {
+	specs := []*gauge.Specification{
+		specDependingOn("/project/specs/a.spec", "b.spec"),
+		specDependingOn("/project/specs/b.spec", "c.spec"),
+		specDependingOn("/project/specs/c.spec", "a.spec"),
+		specDependingOn("/project/specs/d.spec", "a.spec"),
+	}
+
+	cycles := DependencyCycles(specs)
+
+	if len(cycles) != 1 || len(cycles[0]) != 3 {
+		t.Fatalf("Expected a cycle of 3 specs. Got %v", cycles)
+	}
+	if got := fileNames(Sort(specs)); len(got) != len(specs) {
+		t.Errorf("Expected specs in a cycle to be retained. Got %v", got)
+	}
+}
+
+func TestPrerequisitesLeaveOutCycles(t *testing.T) {
+	specs := []*gauge.Specification{
+		specDependingOn("/project/specs/a.spec", "b.spec"),
+		specDependingOn("/project/specs/b.spec", "a.spec"),
+		specDependingOn("/project/specs/c.spec", "a.spec"),
+	}
+
+	got := Prerequisites(specs)
+
+	want := map[string][]string{"/project/specs/c.spec": {"/project/specs/a.spec"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
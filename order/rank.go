@@ -0,0 +1,86 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package order
+
+import (
+	"sort"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	// FailedFirst executes the specs which failed in the last run before the others.
+	FailedFirst = "failed-first"
+	// DurationDesc executes the specs which took longest in the previous runs first.
+	DurationDesc = "duration-desc"
+)
+
+// Ranking holds the paths of the specs, relative to the project root, which are executed first and in the same order
+// for the failed-first and duration-desc sort orders. The other specs are executed afterwards in alphabetical order.
+// It is saved along with the last run command, so that the order can be reproduced.
+var Ranking []string
+
+// IsValid tells if the given sort order is supported.
+func IsValid(sortOrder string) bool {
+	switch sortOrder {
+	case "", "alpha", "random", FailedFirst, DurationDesc:
+		return true
+	}
+	return false
+}
+
+// IsRanked tells if the given sort order executes the specs as per a ranking.
+func IsRanked(sortOrder string) bool {
+	return sortOrder == FailedFirst || sortOrder == DurationDesc
+}
+
+// Rank ranks the specs for the given sort order, from the specs which failed in the last run for failed-first,
+// or from the durations of the specs recorded in the previous runs for duration-desc.
+func Rank(sortOrder string, failed []string, durations map[string]int64) []string {
+	switch sortOrder {
+	case FailedFirst:
+		failed = append([]string(nil), failed...)
+		sort.Strings(failed)
+		return failed
+	case DurationDesc:
+		var specs []string
+		for spec := range durations {
+			specs = append(specs, spec)
+		}
+		sort.Slice(specs, func(i, j int) bool {
+			if durations[specs[i]] != durations[specs[j]] {
+				return durations[specs[i]] > durations[specs[j]]
+			}
+			return specs[i] < specs[j]
+		})
+		return specs
+	}
+	return nil
+}
+
+func sortByRanking(specs []*gauge.Specification, ranking []string) {
+	rank := make(map[string]int)
+	for i, spec := range ranking {
+		if _, ok := rank[spec]; !ok {
+			rank[spec] = i
+		}
+	}
+	rankOf := func(spec *gauge.Specification) int {
+		if r, ok := rank[util.RelPathToProjectRoot(spec.FileName)]; ok {
+			return r
+		}
+		return len(ranking)
+	}
+	sort.SliceStable(specs, func(i, j int) bool {
+		ri, rj := rankOf(specs[i]), rankOf(specs[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return specs[i].FileName < specs[j].FileName
+	})
+}
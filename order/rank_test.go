@@ -0,0 +1,59 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package order
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func TestRankFailedFirst(t *testing.T) {
+	got := Rank(FailedFirst, []string{"specs/c.spec", "specs/a.spec"}, map[string]int64{"specs/b.spec": 300})
+
+	want := []string{"specs/a.spec", "specs/c.spec"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
+
+func TestRankDurationDesc(t *testing.T) {
+	got := Rank(DurationDesc, []string{"specs/c.spec"}, map[string]int64{"specs/a.spec": 10, "specs/b.spec": 300, "specs/c.spec": 10})
+
+	want := []string{"specs/b.spec", "specs/a.spec", "specs/c.spec"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
+
+func TestSortByRanking(t *testing.T) {
+	projectRoot := config.ProjectRoot
+	t.Cleanup(func() { config.ProjectRoot = projectRoot })
+	config.ProjectRoot = t.TempDir()
+	spec := func(name string) *gauge.Specification {
+		return &gauge.Specification{FileName: filepath.Join(config.ProjectRoot, "specs", name)}
+	}
+	specs := []*gauge.Specification{spec("a.spec"), spec("d.spec"), spec("c.spec"), spec("b.spec")}
+	SortOrder = DurationDesc
+	Ranking = []string{filepath.Join("specs", "c.spec"), filepath.Join("specs", "b.spec")}
+	defer func() {
+		SortOrder, Ranking = "", nil
+	}()
+
+	var got []string
+	for _, s := range Sort(specs) {
+		got = append(got, filepath.Base(s.FileName))
+	}
+
+	want := []string{"c.spec", "b.spec", "a.spec", "d.spec"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Want: %v, Got: %v", want, got)
+	}
+}
@@ -32,10 +32,13 @@ func (s byFileName) Less(i, j int) bool {
 	return s[i].FileName < s[j].FileName
 }
 
+// Sort orders the specs as per SortOrder, and then moves every spec after the specs it depends on.
 func Sort(specs []*gauge.Specification) []*gauge.Specification {
 	switch SortOrder {
 	case "alpha":
 		sort.Sort(byFileName(specs))
+	case FailedFirst, DurationDesc:
+		sortByRanking(specs, Ranking)
 	case "random":
 		// RandomSeed should already be set by the execute() function
 		// This ensures the seed is saved for --failed and --repeat
@@ -63,5 +66,5 @@ func Sort(specs []*gauge.Specification) []*gauge.Specification {
 			}
 		}
 	}
-	return specs
+	return sortByDependencies(specs)
 }
@@ -28,6 +28,7 @@ Package parser parses all the specs in the list of directories given and also de
 package parser
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 
@@ -77,6 +78,7 @@ func parseSpecFiles(sfc *specFileCollection, conceptDictionary *gauge.ConceptDic
 }
 
 func ParseSpecFiles(specFiles []string, conceptDictionary *gauge.ConceptDictionary, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, []*ParseResult) {
+	clearResolvedParams()
 	sfc := NewSpecFileCollection(specFiles)
 	piChan := make(chan *parseInfo)
 	limit := len(specFiles)
@@ -109,9 +111,32 @@ func ParseSpecFiles(specFiles []string, conceptDictionary *gauge.ConceptDictiona
 func ParseSpecs(specsToParse []string, conceptsDictionary *gauge.ConceptDictionary, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, bool) {
 	specs, failed := parseSpecsInDirs(conceptsDictionary, specsToParse, buildErrors)
 	specsToExecute := order.Sort(filter.FilterSpecs(specs))
+	if addDependencyCycleErrors(specsToExecute, buildErrors) {
+		failed = true
+	}
 	return specsToExecute, failed
 }
 
+// addDependencyCycleErrors adds a parse error to every spec which is part of a dependency cycle.
+func addDependencyCycleErrors(specs []*gauge.Specification, buildErrors *gauge.BuildErrors) bool {
+	cycles := order.DependencyCycles(specs)
+	for _, cycle := range cycles {
+		var names []string
+		for _, spec := range cycle {
+			names = append(names, util.RelPathToProjectRoot(spec.FileName))
+		}
+		for _, spec := range cycle {
+			err := ParseError{FileName: spec.FileName, Message: fmt.Sprintf("Circular dependency between specs %s", strings.Join(names, ", "))}
+			if spec.Heading != nil {
+				err.LineNo, err.LineText = spec.Heading.LineNo, spec.Heading.Value
+			}
+			logger.Error(true, err.Error())
+			buildErrors.SpecErrs[spec] = append(buildErrors.SpecErrs[spec], err)
+		}
+	}
+	return len(cycles) > 0
+}
+
 // ParseConcepts creates concept dictionary and concept parse result.
 func ParseConcepts() (*gauge.ConceptDictionary, *ParseResult, error) {
 	logger.Debug(true, "Started concepts parsing.")
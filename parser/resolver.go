@@ -8,10 +8,12 @@ package parser
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
 
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/util"
 )
@@ -20,6 +22,10 @@ type invalidSpecialParamError struct {
 	message string
 }
 
+type missingPropertyError struct {
+	message string
+}
+
 type resolverFn func(string) (*gauge.StepArg, error)
 type specialTypeResolver struct {
 	predefinedResolvers map[string]resolverFn
@@ -29,6 +35,10 @@ func (invalidSpecialParamError invalidSpecialParamError) Error() string {
 	return invalidSpecialParamError.message
 }
 
+func (missingPropertyError missingPropertyError) Error() string {
+	return missingPropertyError.message
+}
+
 // Resolve takes a step, a lookup and updates the target after reconciling the dynamic paramters from the given lookup
 func Resolve(step *gauge.Step, parent *gauge.Step, lookup *gauge.ArgLookup, target *gauge_messages.ProtoStep) error {
 	stepParameters, err := getResolvedParams(step, parent, lookup)
@@ -149,7 +159,38 @@ func initializePredefinedResolvers() map[string]resolverFn {
 			}
 			return &gauge.StepArg{Value: fileContent, ArgType: gauge.SpecialString}, nil
 		},
+		"env": func(name string) (*gauge.StepArg, error) {
+			value, ok := os.LookupEnv(name)
+			if !ok {
+				return nil, missingPropertyError{message: fmt.Sprintf("Env variable '%s' is not set", name)}
+			}
+			return &gauge.StepArg{Value: value, ArgType: gauge.SpecialString}, nil
+		},
+		"prop": func(key string) (*gauge.StepArg, error) {
+			value, ok := env.Property(key)
+			if !ok {
+				return nil, missingPropertyError{message: fmt.Sprintf("Property '%s' is not defined in env", key)}
+			}
+			return &gauge.StepArg{Value: value, ArgType: gauge.SpecialString}, nil
+		},
 		"table": func(filePath string) (*gauge.StepArg, error) {
+			if table, generated, err := generateTable(filePath); generated {
+				if err != nil {
+					return nil, err
+				}
+				return &gauge.StepArg{Table: *table, ArgType: gauge.SpecialTable}, nil
+			}
+			if file, path, ok := structuredTableSource(filePath); ok {
+				contents, err := util.GetFileContents(file)
+				if err != nil {
+					return nil, err
+				}
+				table, err := convertStructuredToTable(file, contents, path)
+				if err != nil {
+					return nil, err
+				}
+				return &gauge.StepArg{Table: *table, ArgType: gauge.SpecialTable}, nil
+			}
 			csv, err := util.GetFileContents(filePath)
 			if err != nil {
 				return nil, err
@@ -183,6 +224,9 @@ func (resolver *specialTypeResolver) getStepArg(specialType string, value string
 	if found {
 		return resolveFunc(value)
 	}
+	if resolveFunc, found = registeredResolver(specialType); found {
+		return resolveFunc(value)
+	}
 	return nil, invalidSpecialParamError{message: fmt.Sprintf("Resolver not found for special param <%s>", arg)}
 }
 
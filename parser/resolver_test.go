@@ -7,6 +7,7 @@
 package parser
 
 import (
+	"os"
 	"path/filepath"
 
 	"github.com/getgauge/gauge/gauge"
@@ -277,3 +278,88 @@ func (s *MySuite) TestGetResolveParameterFromDataTable(c *C) {
 	c.Assert(spec.DataTable.Table.Columns[1][0].Value, Equals, "123")
 	c.Assert(spec.DataTable.Table.Columns[1][1].Value, Equals, "007")
 }
+
+func (s *MySuite) TestResolveRegisteredSpecialParam(c *C) {
+	err := RegisterSpecialParam("greeting", false, func(value string) (string, error) {
+		return "hello " + value, nil
+	})
+	c.Assert(err, IsNil)
+	defer delete(registeredResolvers, "greeting")
+
+	stepArg, err := newSpecialTypeResolver().resolve("greeting:world")
+
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.Value, Equals, "hello world")
+	c.Assert(stepArg.ArgType, Equals, gauge.SpecialString)
+	c.Assert(stepArg.Name, Equals, "greeting:world")
+}
+
+func (s *MySuite) TestResolveRegisteredSpecialParamAsTable(c *C) {
+	err := RegisterSpecialParam("users", true, func(value string) (string, error) {
+		return "id,name\n1," + value, nil
+	})
+	c.Assert(err, IsNil)
+	defer delete(registeredResolvers, "users")
+
+	stepArg, err := newSpecialTypeResolver().resolve("users:foo")
+
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.ArgType, Equals, gauge.SpecialTable)
+	c.Assert(stepArg.Table.Rows(), DeepEquals, [][]string{{"1", "foo"}})
+}
+
+func (s *MySuite) TestRegisterPredefinedSpecialParam(c *C) {
+	err := RegisterSpecialParam("file", false, func(value string) (string, error) { return value, nil })
+
+	c.Assert(err, ErrorMatches, "Special param prefix 'file' is predefined")
+}
+
+func (s *MySuite) TestResolveEnvSpecialParam(c *C) {
+	c.Assert(os.Setenv("GAUGE_TEST_BASE_URL", "http://localhost:8080"), IsNil)
+	defer func() {
+		_ = os.Unsetenv("GAUGE_TEST_BASE_URL")
+	}()
+
+	stepArg, err := newSpecialTypeResolver().resolve("env:GAUGE_TEST_BASE_URL")
+
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.Value, Equals, "http://localhost:8080")
+	c.Assert(stepArg.ArgType, Equals, gauge.SpecialString)
+	c.Assert(stepArg.Name, Equals, "env:GAUGE_TEST_BASE_URL")
+}
+
+func (s *MySuite) TestResolveEnvSpecialParamWhenNotSet(c *C) {
+	_, err := newSpecialTypeResolver().resolve("env:GAUGE_TEST_UNSET_VARIABLE")
+
+	c.Assert(err, ErrorMatches, "Env variable 'GAUGE_TEST_UNSET_VARIABLE' is not set")
+}
+
+func (s *MySuite) TestResolvePropSpecialParamWhenNotDefined(c *C) {
+	_, err := newSpecialTypeResolver().resolve("prop:gauge_test_unknown_key")
+
+	c.Assert(err, ErrorMatches, "Property 'gauge_test_unknown_key' is not defined in env")
+}
+
+func (s *MySuite) TestRegisteredSpecialParamIsResolvedOnceForAParse(c *C) {
+	calls := 0
+	err := RegisterSpecialParam("counter", false, func(value string) (string, error) {
+		calls++
+		return value, nil
+	})
+	c.Assert(err, IsNil)
+	defer delete(registeredResolvers, "counter")
+	defer clearResolvedParams()
+
+	_, err = newSpecialTypeResolver().resolve("counter:a")
+	c.Assert(err, IsNil)
+	_, err = newSpecialTypeResolver().resolve("counter:a")
+	c.Assert(err, IsNil)
+	_, err = newSpecialTypeResolver().resolve("counter:b")
+	c.Assert(err, IsNil)
+	c.Assert(calls, Equals, 2)
+
+	clearResolvedParams()
+	_, err = newSpecialTypeResolver().resolve("counter:a")
+	c.Assert(err, IsNil)
+	c.Assert(calls, Equals, 3)
+}
@@ -0,0 +1,120 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+// SpecialParamResolver gives the contents of a special param from its value, like the rows of "users" for <sql:users>.
+type SpecialParamResolver func(value string) (string, error)
+
+var (
+	registeredResolvers = make(map[string]resolverFn)
+	resolversMutex      = &sync.RWMutex{}
+)
+
+// resolvedParam holds the contents of a registered special param, resolved once for a parse.
+type resolvedParam struct {
+	once     sync.Once
+	contents string
+	err      error
+}
+
+type resolvedParamKey struct {
+	prefix string
+	value  string
+}
+
+var (
+	resolvedParams      = make(map[resolvedParamKey]*resolvedParam)
+	resolvedParamsMutex = &sync.Mutex{}
+)
+
+// RegisterSpecialParam registers a resolver for the special params with the given prefix, like "sql" for <sql:users>,
+// so that they are resolved while parsing along with the file and table params. The contents given by the resolver
+// are taken as a table in CSV if asTable is set, and as text otherwise.
+func RegisterSpecialParam(prefix string, asTable bool, resolve SpecialParamResolver) error {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" || strings.ContainsAny(prefix, ":<> \t") {
+		return fmt.Errorf("Invalid special param prefix '%s'", prefix)
+	}
+	if _, ok := initializePredefinedResolvers()[prefix]; ok {
+		return fmt.Errorf("Special param prefix '%s' is predefined", prefix)
+	}
+	resolversMutex.Lock()
+	defer resolversMutex.Unlock()
+	if _, ok := registeredResolvers[prefix]; ok {
+		return fmt.Errorf("Special param prefix '%s' is already registered", prefix)
+	}
+	registeredResolvers[prefix] = func(value string) (*gauge.StepArg, error) {
+		contents, err := resolveOnce(prefix, value, resolve)
+		if err != nil {
+			return nil, err
+		}
+		if !asTable {
+			return &gauge.StepArg{Value: contents, ArgType: gauge.SpecialString}, nil
+		}
+		table, err := convertCsvToTable(contents)
+		if err != nil {
+			return nil, err
+		}
+		return &gauge.StepArg{Table: *table, ArgType: gauge.SpecialTable}, nil
+	}
+	return nil
+}
+
+// resolveOnce resolves the special param with the given prefix and value only once for a parse, as the resolvers
+// registered by plugins and runners run a command for every param.
+func resolveOnce(prefix, value string, resolve SpecialParamResolver) (string, error) {
+	resolvedParamsMutex.Lock()
+	key := resolvedParamKey{prefix: prefix, value: value}
+	p, ok := resolvedParams[key]
+	if !ok {
+		p = &resolvedParam{}
+		resolvedParams[key] = p
+	}
+	resolvedParamsMutex.Unlock()
+	p.once.Do(func() {
+		p.contents, p.err = resolve(value)
+	})
+	return p.contents, p.err
+}
+
+// clearResolvedParams lets the registered special params be resolved again, so that every parse gets their latest contents.
+func clearResolvedParams() {
+	resolvedParamsMutex.Lock()
+	defer resolvedParamsMutex.Unlock()
+	resolvedParams = make(map[resolvedParamKey]*resolvedParam)
+}
+
+func registeredResolver(prefix string) (resolverFn, bool) {
+	resolversMutex.RLock()
+	defer resolversMutex.RUnlock()
+	r, ok := registeredResolvers[prefix]
+	return r, ok
+}
+
+// specialParamPrefixes gives the prefixes of the special params which can be resolved, in alphabetical order.
+func specialParamPrefixes() []string {
+	var prefixes []string
+	for prefix := range initializePredefinedResolvers() {
+		prefixes = append(prefixes, prefix)
+	}
+	resolversMutex.RLock()
+	for prefix := range registeredResolvers {
+		prefixes = append(prefixes, prefix)
+	}
+	resolversMutex.RUnlock()
+	sort.Strings(prefixes)
+	return prefixes
+}
@@ -932,6 +932,30 @@ func (s *MySuite) TestCreateInValidSpecialArgInStep(c *C) {
 	c.Assert(parseResults.Warnings[0].Message, Equals, "Could not resolve special param type <unknown:foo>. Treating it as dynamic param.")
 }
 
+func (s *MySuite) TestCreateUnknownSpecialArgInStep(c *C) {
+	tokens := []*Token{
+		{Kind: gauge.SpecKind, Value: "Spec Heading", LineNo: 1},
+		{Kind: gauge.ScenarioKind, Value: "Scenario Heading", LineNo: 2},
+		{Kind: gauge.StepKind, Value: "Example {special} step", LineNo: 3, Args: []string{"unknown:foo"}},
+	}
+	_, parseResults, err := new(SpecParser).CreateSpecification(tokens, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+	c.Assert(len(parseResults.ParseErrors), Equals, 1)
+	c.Assert(parseResults.ParseErrors[0].Message, Equals, "Resolver not found for special param <unknown:foo>. Special params can be of type env, file, prop, table")
+}
+
+func (s *MySuite) TestCreateEnvSpecialArgInStepWhenNotSet(c *C) {
+	tokens := []*Token{
+		{Kind: gauge.SpecKind, Value: "Spec Heading", LineNo: 1},
+		{Kind: gauge.ScenarioKind, Value: "Scenario Heading", LineNo: 2},
+		{Kind: gauge.StepKind, Value: "Open {special}", LineNo: 3, Args: []string{"env:GAUGE_TEST_UNSET_URL"}},
+	}
+	_, parseResults, err := new(SpecParser).CreateSpecification(tokens, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+	c.Assert(len(parseResults.ParseErrors), Equals, 1)
+	c.Assert(parseResults.ParseErrors[0].Message, Equals, "Dynamic parameter <env:GAUGE_TEST_UNSET_URL> could not be resolved. Env variable 'GAUGE_TEST_UNSET_URL' is not set")
+}
+
 func (s *MySuite) TestTearDownSteps(c *C) {
 	tokens := []*Token{
 		{Kind: gauge.SpecKind, Value: "Spec Heading", LineNo: 1},
@@ -233,7 +233,14 @@ func createStepArg(argValue string, typeOfArg string, token *Token, lookup *gaug
 		if err != nil {
 			switch err.(type) {
 			case invalidSpecialParamError:
-				return treatArgAsDynamic(argValue, token, lookup, fileName)
+				if isConceptHeader(lookup) || lookup.ContainsArg(argValue) {
+					return treatArgAsDynamic(argValue, token, lookup, fileName)
+				}
+				message := fmt.Sprintf("%s. Special params can be of type %s", err.Error(), strings.Join(specialParamPrefixes(), ", "))
+				return &gauge.StepArg{ArgType: gauge.Dynamic, Value: argValue, Name: argValue}, &ParseResult{ParseErrors: []ParseError{ParseError{FileName: fileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: message, LineText: token.LineText()}}}
+			case missingPropertyError:
+				message := fmt.Sprintf("Dynamic parameter <%s> could not be resolved. %s", argValue, err.Error())
+				return &gauge.StepArg{ArgType: gauge.Dynamic, Value: argValue, Name: argValue}, &ParseResult{ParseErrors: []ParseError{ParseError{FileName: fileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: message, LineText: token.LineText()}}}
 			default:
 				return &gauge.StepArg{ArgType: gauge.Dynamic, Value: argValue, Name: argValue}, &ParseResult{ParseErrors: []ParseError{ParseError{FileName: fileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: fmt.Sprintf("Dynamic parameter <%s> could not be resolved", argValue), LineText: token.LineText()}}}
 			}
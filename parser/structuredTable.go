@@ -0,0 +1,233 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"go.yaml.in/yaml/v3"
+)
+
+// isStructuredTable tells if the data of a table is in a JSON or YAML file, rather than in a CSV file.
+func isStructuredTable(file string) bool {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// structuredTableSource splits the source of a table into the JSON or YAML file and the path of the rows within it,
+// like "fixtures/users.json#data.users". The path is empty if the rows are at the top of the file.
+func structuredTableSource(source string) (file string, path string, ok bool) {
+	file, path, _ = strings.Cut(source, "#")
+	return strings.TrimSpace(file), strings.TrimSpace(path), isStructuredTable(strings.TrimSpace(file))
+}
+
+// convertStructuredToTable converts an array of objects in JSON or YAML to a table, with a row for each object.
+// The rows are taken from the given path, whose fields are separated by dots, like "data.users" or "results.0.items".
+// Nested objects are flattened into columns named by the path of their fields, like "address.city",
+// while nested arrays are given as JSON.
+func convertStructuredToTable(file, contents, path string) (*gauge.Table, error) {
+	doc, err := decodeStructured(file, contents)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("No data found in %s", file)
+	}
+	node, err := selectNode(doc.Content[0], path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to select '%s' in %s. %s", path, file, err.Error())
+	}
+	if node.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("Expected an array of objects at '%s' in %s", path, file)
+	}
+	if len(node.Content) == 0 {
+		return nil, fmt.Errorf("No rows found at '%s' in %s", path, file)
+	}
+	var headers []string
+	var rows []map[string]string
+	columns := make(map[string]bool)
+	for i, item := range node.Content {
+		item = resolveAlias(item)
+		if item.Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("Expected an object at row %d of '%s' in %s", i+1, path, file)
+		}
+		row := make(map[string]string)
+		if err := flatten(item, "", row, &headers, columns); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	table := new(gauge.Table)
+	table.AddHeaders(headers)
+	for _, row := range rows {
+		var values []string
+		for _, h := range headers {
+			values = append(values, row[h])
+		}
+		table.AddRowValues(table.CreateTableCells(values))
+	}
+	return table, nil
+}
+
+// decodeStructured decodes the contents of the JSON or YAML file into a document node.
+func decodeStructured(file, contents string) (*yaml.Node, error) {
+	if strings.ToLower(filepath.Ext(file)) == ".json" {
+		doc, err := decodeJSON(contents)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid JSON in %s. %s", file, err.Error())
+		}
+		return doc, nil
+	}
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(contents), doc); err != nil {
+		return nil, fmt.Errorf("Invalid YAML in %s. %s", file, err.Error())
+	}
+	return doc, nil
+}
+
+// decodeJSON decodes the JSON into the nodes YAML would give for it, keeping the order of the fields of the objects.
+// JSON is not decoded as YAML, as not all JSON is valid YAML, like strings with the escape \/.
+func decodeJSON(contents string) (*yaml.Node, error) {
+	d := json.NewDecoder(strings.NewReader(contents))
+	d.UseNumber()
+	node, err := decodeJSONValue(d)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid data after the top-level value")
+	}
+	return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{node}}, nil
+}
+
+func decodeJSONValue(d *json.Decoder) (*yaml.Node, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch v := t.(type) {
+	case json.Delim:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if v == '{' {
+			node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+		for d.More() {
+			if node.Kind == yaml.MappingNode {
+				key, err := d.Token()
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key.(string)})
+			}
+			value, err := decodeJSONValue(d)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, value)
+		}
+		// the closing delimiter of the object or array.
+		if _, err := d.Token(); err != nil {
+			return nil, err
+		}
+		return node, nil
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}, nil
+	case json.Number:
+		tag := "!!float"
+		if _, err := v.Int64(); err == nil {
+			tag = "!!int"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v)}, nil
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+}
+
+func selectNode(node *yaml.Node, path string) (*yaml.Node, error) {
+	if path == "" {
+		return resolveAlias(node), nil
+	}
+	for _, field := range strings.Split(path, ".") {
+		node = resolveAlias(node)
+		switch node.Kind {
+		case yaml.MappingNode:
+			var found *yaml.Node
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == field {
+					found = node.Content[i+1]
+					break
+				}
+			}
+			if found == nil {
+				return nil, fmt.Errorf("Field '%s' not found", field)
+			}
+			node = found
+		case yaml.SequenceNode:
+			i, err := strconv.Atoi(field)
+			if err != nil || i < 0 || i >= len(node.Content) {
+				return nil, fmt.Errorf("Index '%s' is out of range", field)
+			}
+			node = node.Content[i]
+		default:
+			return nil, fmt.Errorf("Field '%s' not found", field)
+		}
+	}
+	return resolveAlias(node), nil
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+// flatten adds the fields of the object to the row, with the fields of nested objects prefixed by the path to them.
+// The columns are added to the headers in the order they are first found.
+func flatten(object *yaml.Node, prefix string, row map[string]string, headers *[]string, columns map[string]bool) error {
+	for i := 0; i+1 < len(object.Content); i += 2 {
+		column := prefix + object.Content[i].Value
+		value := resolveAlias(object.Content[i+1])
+		if value.Kind == yaml.MappingNode {
+			if err := flatten(value, column+".", row, headers, columns); err != nil {
+				return err
+			}
+			continue
+		}
+		if !columns[column] {
+			columns[column] = true
+			*headers = append(*headers, column)
+		}
+		if value.Kind == yaml.ScalarNode {
+			if value.Tag != "!!null" {
+				row[column] = value.Value
+			}
+			continue
+		}
+		var v interface{}
+		if err := value.Decode(&v); err != nil {
+			return err
+		}
+		s, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("Failed to convert '%s' to JSON. %s", column, err.Error())
+		}
+		row[column] = string(s)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestConvertJSONToTableFlattensNestedFields(c *C) {
+	contents := `[
+		{"name": "alice", "address": {"city": "Pune", "zip": "411001"}, "roles": ["admin", "dev"]},
+		{"name": "bob", "address": {"city": "Chennai"}, "active": null}
+	]`
+
+	table, err := convertStructuredToTable("users.json", contents, "")
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"name", "address.city", "address.zip", "roles", "active"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"alice", "Pune", "411001", `["admin","dev"]`, ""}, {"bob", "Chennai", "", "", ""}})
+}
+
+func (s *MySuite) TestConvertYAMLToTableAtPath(c *C) {
+	contents := `
+results:
+  - items:
+      - id: 1
+        tags: {env: prod}
+      - id: 2
+        tags: {env: qa}
+`
+
+	table, err := convertStructuredToTable("results.yaml", contents, "results.0.items")
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"id", "tags.env"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"1", "prod"}, {"2", "qa"}})
+}
+
+func (s *MySuite) TestConvertStructuredToTableWithMissingPath(c *C) {
+	_, err := convertStructuredToTable("users.json", `{"data": {"users": []}}`, "data.people")
+
+	c.Assert(err, ErrorMatches, "Failed to select 'data.people' in users.json. Field 'people' not found")
+}
+
+func (s *MySuite) TestConvertStructuredToTableWhenPathIsNotAnArray(c *C) {
+	_, err := convertStructuredToTable("users.json", `{"data": {"users": {"name": "alice"}}}`, "data.users")
+
+	c.Assert(err, ErrorMatches, "Expected an array of objects at 'data.users' in users.json")
+}
+
+func (s *MySuite) TestConvertStructuredToTableWithInvalidJSON(c *C) {
+	_, err := convertStructuredToTable("users.json", `name: alice`, "")
+
+	c.Assert(err, ErrorMatches, "Invalid JSON in users.json.*")
+}
+
+func (s *MySuite) TestStructuredTableSource(c *C) {
+	file, path, ok := structuredTableSource("fixtures/users.json#data.users")
+	c.Assert(ok, Equals, true)
+	c.Assert(file, Equals, "fixtures/users.json")
+	c.Assert(path, Equals, "data.users")
+
+	_, _, ok = structuredTableSource("data/users.csv")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *MySuite) TestParseScenarioWithJSONDataTable(c *C) {
+	spec, res, err := new(SpecParser).Parse(`Specification Heading
+=====================
+
+Users
+-----
+table:testdata/users.json#data.users
+
+* User <name> lives in <address.city>.
+`, gauge.NewConceptDictionary(), "")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.Scenarios[0].DataTable.Table.Headers, DeepEquals, []string{"name", "address.city", "address.zip", "roles", "active"})
+	c.Assert(spec.Scenarios[0].DataTable.Table.GetRowCount(), Equals, 2)
+}
+
+func (s *MySuite) TestConvertJSONToTableKeepsOrderOfFieldsAndEscapes(c *C) {
+	contents := `{"data": [{"url": "https:\/\/example.com\/a", "id": 12, "score": 1.5, "active": true, "tags": ["x", 2]}]}`
+
+	table, err := convertStructuredToTable("links.json", contents, "data")
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"url", "id", "score", "active", "tags"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"https://example.com/a", "12", "1.5", "true", `["x",2]`}})
+}
+
+func (s *MySuite) TestConvertStructuredToTableWithDataAfterJSON(c *C) {
+	_, err := convertStructuredToTable("users.json", `[{"name": "alice"}] []`, "")
+
+	c.Assert(err, ErrorMatches, "Invalid JSON in users.json.*")
+}
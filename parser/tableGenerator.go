@@ -0,0 +1,182 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+const (
+	cartesianGenerator = "cartesian"
+	pairwiseGenerator  = "pairwise"
+)
+
+var generatedTable = regexp.MustCompile(`(?is)^(cartesian|pairwise)\s*\((.*)\)$`)
+
+// dimension is a column of a generated table, with the values it takes.
+type dimension struct {
+	name   string
+	values []string
+}
+
+// generateTable generates the rows of a data table declared as combinations of the values of its columns,
+// like "pairwise(browser: chrome, firefox; locale: en, fr; plan: free, pro)". A cartesian table has a row
+// for every combination of the values, while a pairwise table covers every pair of values of any two columns.
+// It is false if the table is not declared as combinations.
+func generateTable(value string) (*gauge.Table, bool, error) {
+	match := generatedTable.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return nil, false, nil
+	}
+	dims, err := parseDimensions(match[2])
+	if err != nil {
+		return nil, true, err
+	}
+	var rows [][]string
+	if strings.ToLower(match[1]) == pairwiseGenerator {
+		rows = allPairs(dims)
+	} else {
+		rows = cartesian(dims)
+	}
+	table := new(gauge.Table)
+	var headers []string
+	for _, d := range dims {
+		headers = append(headers, d.name)
+	}
+	table.AddHeaders(headers)
+	for _, row := range rows {
+		table.AddRowValues(table.CreateTableCells(row))
+	}
+	return table, true, nil
+}
+
+func parseDimensions(text string) ([]dimension, error) {
+	var dims []dimension
+	seen := make(map[string]bool)
+	for _, d := range strings.Split(text, ";") {
+		if strings.TrimSpace(d) == "" {
+			continue
+		}
+		name, values, found := strings.Cut(d, ":")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("Column '%s' should be of format name: value1, value2", strings.TrimSpace(d))
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("Column '%s' is declared more than once", name)
+		}
+		seen[name] = true
+		dim := dimension{name: name}
+		for _, v := range strings.Split(values, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				dim.values = append(dim.values, v)
+			}
+		}
+		if len(dim.values) == 0 {
+			return nil, fmt.Errorf("Column '%s' has no values", name)
+		}
+		dims = append(dims, dim)
+	}
+	if len(dims) == 0 {
+		return nil, fmt.Errorf("Table should have at least 1 column")
+	}
+	return dims, nil
+}
+
+// cartesian gives a row for every combination of the values, varying the values of the last column first.
+func cartesian(dims []dimension) [][]string {
+	rows := [][]string{{}}
+	for _, d := range dims {
+		var next [][]string
+		for _, row := range rows {
+			for _, v := range d.values {
+				next = append(next, append(append([]string{}, row...), v))
+			}
+		}
+		rows = next
+	}
+	return rows
+}
+
+// valuePair is a pair of values of two columns, identified by their indexes.
+type valuePair struct {
+	col1, value1, col2, value2 int
+}
+
+// allPairs gives rows covering every pair of values of any two columns. The rows are built greedily, starting each one with
+// the first pair not covered yet and picking the values of the other columns which cover the most pairs not covered yet.
+func allPairs(dims []dimension) [][]string {
+	if len(dims) < 3 {
+		return cartesian(dims)
+	}
+	var pairs []valuePair
+	uncovered := make(map[valuePair]bool)
+	for c1 := range dims {
+		for c2 := c1 + 1; c2 < len(dims); c2++ {
+			for v1 := range dims[c1].values {
+				for v2 := range dims[c2].values {
+					p := valuePair{c1, v1, c2, v2}
+					pairs = append(pairs, p)
+					uncovered[p] = true
+				}
+			}
+		}
+	}
+	var rows [][]string
+	for _, first := range pairs {
+		if !uncovered[first] {
+			continue
+		}
+		row := make([]int, len(dims))
+		for c := range row {
+			row[c] = -1
+		}
+		row[first.col1], row[first.col2] = first.value1, first.value2
+		for c := range dims {
+			if row[c] < 0 {
+				row[c] = mostCoveringValue(dims, row, c, uncovered)
+			}
+		}
+		values := make([]string, len(dims))
+		for c, v := range row {
+			values[c] = dims[c].values[v]
+			for other := c + 1; other < len(dims); other++ {
+				delete(uncovered, valuePair{c, v, other, row[other]})
+			}
+		}
+		rows = append(rows, values)
+	}
+	return rows
+}
+
+// mostCoveringValue gives the value of the column which covers the most pairs not covered yet, with the values chosen for the row so far.
+func mostCoveringValue(dims []dimension, row []int, col int, uncovered map[valuePair]bool) int {
+	best, bestCount := 0, -1
+	for v := range dims[col].values {
+		count := 0
+		for c, chosen := range row {
+			if c == col || chosen < 0 {
+				continue
+			}
+			p := valuePair{c, chosen, col, v}
+			if col < c {
+				p = valuePair{col, v, c, chosen}
+			}
+			if uncovered[p] {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = v, count
+		}
+	}
+	return best
+}
@@ -0,0 +1,76 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestGenerateCartesianTable(c *C) {
+	table, generated, err := generateTable("cartesian(browser: chrome, firefox; plan: free, pro)")
+
+	c.Assert(err, IsNil)
+	c.Assert(generated, Equals, true)
+	c.Assert(table.Headers, DeepEquals, []string{"browser", "plan"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"chrome", "free"}, {"chrome", "pro"}, {"firefox", "free"}, {"firefox", "pro"}})
+}
+
+func (s *MySuite) TestGeneratePairwiseTableCoversAllPairs(c *C) {
+	dims := "browser: chrome, firefox, safari; locale: en, fr, de; plan: free, pro, team; os: linux, mac"
+	table, generated, err := generateTable("pairwise(" + dims + ")")
+
+	c.Assert(err, IsNil)
+	c.Assert(generated, Equals, true)
+	full, _, _ := generateTable("cartesian(" + dims + ")")
+	c.Assert(table.GetRowCount() < full.GetRowCount(), Equals, true, Commentf("Expected fewer rows than the %d combinations. Got %d", full.GetRowCount(), table.GetRowCount()))
+	rows := table.Rows()
+	fullRows := full.Rows()
+	for c1 := range table.Headers {
+		for c2 := c1 + 1; c2 < len(table.Headers); c2++ {
+			for _, want := range fullRows {
+				covered := false
+				for _, row := range rows {
+					if row[c1] == want[c1] && row[c2] == want[c2] {
+						covered = true
+						break
+					}
+				}
+				c.Assert(covered, Equals, true, Commentf("Pair %s=%s, %s=%s not covered", table.Headers[c1], want[c1], table.Headers[c2], want[c2]))
+			}
+		}
+	}
+}
+
+func (s *MySuite) TestGenerateTableIgnoresFilePaths(c *C) {
+	_, generated, err := generateTable("data/users.csv")
+
+	c.Assert(err, IsNil)
+	c.Assert(generated, Equals, false)
+}
+
+func (s *MySuite) TestGenerateTableWithInvalidColumn(c *C) {
+	_, generated, err := generateTable("pairwise(browser: chrome; locale)")
+
+	c.Assert(generated, Equals, true)
+	c.Assert(err, ErrorMatches, "Column 'locale' should be of format name: value1, value2")
+}
+
+func (s *MySuite) TestSpecWithGeneratedDataTable(c *C) {
+	specText := newSpecBuilder().specHeading("Spec heading").
+		text("table: cartesian(browser: chrome, firefox; plan: free, pro)").
+		scenarioHeading("Scenario heading").
+		step("open <browser> with <plan>").String()
+
+	spec, result, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(spec.DataTable.IsExternal, Equals, true)
+	c.Assert(spec.DataTable.Table.GetRowCount(), Equals, 4)
+	c.Assert(GetSpecsForDataTableRows([]*gauge.Specification{spec}, gauge.NewBuildErrors()), HasLen, 4)
+}
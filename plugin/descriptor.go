@@ -32,6 +32,7 @@ type PluginDescriptor struct {
 	GaugeVersionSupport version.VersionSupport
 	pluginPath          string
 	Capabilities        []string
+	SpecialParams       []SpecialParam
 }
 
 func (pd *PluginDescriptor) hasScope(scope pluginScope) bool {
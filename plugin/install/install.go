@@ -593,8 +593,10 @@ func HandleUpdateResult(result InstallResult, pluginName string, exitIfFailure b
 
 func installPluginsFromManifest(manifest *manifest.Manifest, silent, languageOnly bool) {
 	pluginsMap := make(map[string]bool)
-	if manifest.Language != "" {
-		pluginsMap[manifest.Language] = true
+	for _, language := range manifest.AllLanguages() {
+		if language != "" {
+			pluginsMap[language] = true
+		}
 	}
 
 	if !languageOnly {
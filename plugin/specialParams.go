@@ -0,0 +1,137 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/parser"
+)
+
+const (
+	specialParamText  = "text"
+	specialParamTable = "table"
+)
+
+// SpecialParam is a type of special param provided by a plugin or a language runner, like "sql" for <sql:users>.
+// A param is resolved by running the command with the value of the param as its last argument, in the directory of
+// the plugin or runner. The command writes the contents of the param to stdout, in CSV if the type is "table".
+type SpecialParam struct {
+	Prefix  string
+	Type    string
+	Command struct {
+		Windows []string
+		Linux   []string
+		Darwin  []string
+	}
+}
+
+var registerSpecialParamsOnce sync.Once
+
+// RegisterSpecialParams registers the special param types provided by the language runners and plugins of the project,
+// so that the params of those types are resolved while parsing the specs. They are registered only once, and the warnings
+// about the types which could not be registered are returned the first time.
+func RegisterSpecialParams(m *manifest.Manifest) (warnings []string) {
+	registerSpecialParamsOnce.Do(func() {
+		warnings = registerSpecialParams(m, true)
+	})
+	return
+}
+
+// RegisterSpecialParamTypes registers the special param types provided by the language runners and plugins of the project,
+// without running their commands. The params of those types are parsed, but left unresolved. It is meant for the language
+// server, which parses the specs on every change.
+func RegisterSpecialParamTypes(m *manifest.Manifest) (warnings []string) {
+	registerSpecialParamsOnce.Do(func() {
+		warnings = registerSpecialParams(m, false)
+	})
+	return
+}
+
+func registerSpecialParams(m *manifest.Manifest, resolve bool) (warnings []string) {
+	for _, language := range m.AllLanguages() {
+		languageJSON, err := GetLanguageJSONFilePath(language)
+		if err != nil {
+			continue
+		}
+		contents, err := common.ReadFileContents(languageJSON)
+		if err != nil {
+			continue
+		}
+		var r struct{ SpecialParams []SpecialParam }
+		if err = json.Unmarshal([]byte(contents), &r); err != nil {
+			continue
+		}
+		warnings = append(warnings, registerAll(language, filepath.Dir(languageJSON), r.SpecialParams, resolve)...)
+	}
+	for _, pluginID := range m.Plugins {
+		pd, err := GetPluginDescriptor(pluginID, "")
+		if err != nil {
+			continue
+		}
+		warnings = append(warnings, registerAll(pd.ID, pd.pluginPath, pd.SpecialParams, resolve)...)
+	}
+	return
+}
+
+func registerAll(provider, dir string, params []SpecialParam, resolve bool) (warnings []string) {
+	for _, sp := range params {
+		if err := sp.register(dir, resolve); err != nil {
+			warnings = append(warnings, fmt.Sprintf("Unable to register special param type '%s' of %s. %s", sp.Prefix, provider, err.Error()))
+		}
+	}
+	return
+}
+
+func (sp SpecialParam) register(dir string, resolve bool) error {
+	t := strings.ToLower(sp.Type)
+	if t != specialParamText && t != specialParamTable {
+		return fmt.Errorf("Type should be %s or %s.", specialParamText, specialParamTable)
+	}
+	command := sp.command()
+	if len(command) == 0 {
+		return fmt.Errorf("Platform specific command not specified: %s.", runtime.GOOS)
+	}
+	if !resolve {
+		return parser.RegisterSpecialParam(sp.Prefix, false, func(value string) (string, error) {
+			return fmt.Sprintf("<%s:%s>", sp.Prefix, value), nil
+		})
+	}
+	return parser.RegisterSpecialParam(sp.Prefix, t == specialParamTable, func(value string) (string, error) {
+		cmd := common.GetExecutableCommand(false, append(append([]string{}, command...), value)...)
+		cmd.Dir = dir
+		out, err := cmd.Output()
+		if err != nil {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+				return "", fmt.Errorf("%s. %s", err.Error(), strings.TrimSpace(string(exitErr.Stderr)))
+			}
+			return "", err
+		}
+		return string(out), nil
+	})
+}
+
+func (sp SpecialParam) command() []string {
+	switch runtime.GOOS {
+	case "windows":
+		return sp.Command.Windows
+	case "darwin":
+		return sp.Command.Darwin
+	default:
+		return sp.Command.Linux
+	}
+}
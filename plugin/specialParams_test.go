@@ -0,0 +1,75 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package plugin
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+func TestRegisteredSpecialParamIsResolvedByRunningCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the command of the special param is a shell script")
+	}
+	sp := SpecialParam{Prefix: "fixture", Type: "Table"}
+	sp.Command.Linux = []string{"/bin/sh", "-c", `printf 'name,role\n%s,admin\n' "$0"`}
+	sp.Command.Darwin = sp.Command.Linux
+
+	warnings := registerAll("fixtures-plugin", t.TempDir(), []SpecialParam{sp}, true)
+	if len(warnings) > 0 {
+		t.Fatalf("Expected no warnings. Got %v", warnings)
+	}
+
+	spec, res, err := new(parser.SpecParser).Parse("# Spec\n## Scenario\n* Create users <fixture:alice>\n", gauge.NewConceptDictionary(), "")
+	if err != nil || !res.Ok {
+		t.Fatalf("Expected the spec to be parsed. Got %v %v", err, res.ParseErrors)
+	}
+	arg := spec.Scenarios[0].Steps[0].Args[0]
+	if arg.ArgType != gauge.SpecialTable {
+		t.Fatalf("Expected a special table. Got %s", arg.ArgType)
+	}
+	if rows := arg.Table.Rows(); len(rows) != 1 || rows[0][0] != "alice" || rows[0][1] != "admin" {
+		t.Errorf("Expected the table written by the command. Got %v", rows)
+	}
+}
+
+func TestRegisterSpecialParamWithInvalidType(t *testing.T) {
+	sp := SpecialParam{Prefix: "query", Type: "json"}
+	sp.Command.Linux = []string{"bin/query"}
+	sp.Command.Darwin = sp.Command.Linux
+	sp.Command.Windows = sp.Command.Linux
+
+	warnings := registerAll("db-plugin", t.TempDir(), []SpecialParam{sp}, true)
+
+	want := "Unable to register special param type 'query' of db-plugin. Type should be text or table."
+	if len(warnings) != 1 || warnings[0] != want {
+		t.Errorf("Expected warning %q. Got %v", want, warnings)
+	}
+}
+
+func TestSpecialParamTypeIsRegisteredWithoutRunningCommand(t *testing.T) {
+	sp := SpecialParam{Prefix: "ldap", Type: "table"}
+	sp.Command.Linux = []string{"bin/missing-ldap-query"}
+	sp.Command.Darwin = sp.Command.Linux
+	sp.Command.Windows = sp.Command.Linux
+
+	warnings := registerAll("ldap-plugin", t.TempDir(), []SpecialParam{sp}, false)
+	if len(warnings) > 0 {
+		t.Fatalf("Expected no warnings. Got %v", warnings)
+	}
+
+	spec, res, err := new(parser.SpecParser).Parse("# Spec\n## Scenario\n* Create users <ldap:admins>\n", gauge.NewConceptDictionary(), "")
+	if err != nil || !res.Ok {
+		t.Fatalf("Expected the spec to be parsed without running the command. Got %v %v", err, res.ParseErrors)
+	}
+	if arg := spec.Scenarios[0].Steps[0].Args[0]; arg.ArgType != gauge.SpecialString || arg.Value != "<ldap:admins>" {
+		t.Errorf("Expected the param to be left unresolved. Got %s %s", arg.ArgType, arg.Value)
+	}
+}
@@ -125,9 +125,11 @@ func installRunner(silent bool) {
 		logger.Errorf(true, "failed to install language runner. %s", err.Error())
 		return
 	}
-	if !install.IsCompatiblePluginInstalled(m.Language, true) {
-		logger.Infof(true, "Compatible language plugin %s is not installed. Installing plugin...", m.Language)
-		install.HandleInstallResult(install.Plugin(m.Language, "", silent), m.Language, true)
+	for _, language := range m.AllLanguages() {
+		if !install.IsCompatiblePluginInstalled(language, true) {
+			logger.Infof(true, "Compatible language plugin %s is not installed. Installing plugin...", language)
+			install.HandleInstallResult(install.Plugin(language, "", silent), language, true)
+		}
 	}
 }
 
@@ -77,6 +77,10 @@ func (c *coloredConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result
 	}
 
 	printHookFailureCC(c, res, res.GetPostHook)
+	if isQuarantinedFailure(res) {
+		logger.Info(false, quarantinedMessage)
+		c.displayMessage(indent(quarantinedMessage, c.indentation)+newline, ct.Magenta)
+	}
 	c.indentation -= scenarioIndentation
 	c.writer.Reset()
 	c.sceFailuresBuf.Reset()
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/util"
 )
 
@@ -21,12 +22,18 @@ const (
 	failureSymbol       = "✘"
 	successChar         = "P"
 	failureChar         = "F"
+	quarantinedMessage  = "Quarantined: this failure does not fail the execution"
 )
 
 func formatScenario(scenarioHeading string) string {
 	return fmt.Sprintf("## %s", scenarioHeading)
 }
 
+func isQuarantinedFailure(res result.Result) bool {
+	r, ok := res.(*result.ScenarioResult)
+	return ok && r.GetQuarantinedFailure()
+}
+
 func formatSpec(specHeading string) string {
 	return fmt.Sprintf("# %s", specHeading)
 }
@@ -35,6 +35,7 @@ const (
 	pass          status    = "pass"
 	fail          status    = "fail"
 	skip          status    = "skip"
+	quarantined   status    = "quarantined"
 )
 
 type jsonConsole struct {
@@ -242,6 +243,9 @@ func getIDWithRow(name string, scenarios []*gauge.Scenario, isDataTable bool) st
 }
 
 func getScenarioStatus(result *result.ScenarioResult) status {
+	if result.GetQuarantinedFailure() {
+		return quarantined
+	}
 	return getStatus(result.ProtoScenario.GetExecutionStatus() == gm.ExecutionStatus_FAILED,
 		result.ProtoScenario.GetExecutionStatus() == gm.ExecutionStatus_SKIPPED)
 }
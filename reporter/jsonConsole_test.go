@@ -857,3 +857,41 @@ func (s *MySuite) TestScenarioEndWithScenarioDataTable_JSONConsole(c *C) {
 	jc.ScenarioEnd(scenario, &result.ScenarioResult{ProtoScenario: protoScenario}, info)
 	c.Assert(dw.output, Equals, expected)
 }
+
+func (s *MySuite) TestScenarioEndWithQuarantinedFailure_JSONConsole(c *C) {
+	dw, jc := setupJSONConsole()
+
+	protoScenario := &gauge_messages.ProtoScenario{
+		ScenarioHeading: "Scenario",
+		ExecutionStatus: gauge_messages.ExecutionStatus_FAILED,
+	}
+
+	scenario := &gauge.Scenario{
+		Heading: &gauge.Heading{
+			Value:       "Scenario",
+			LineNo:      2,
+			HeadingType: 1,
+		},
+		Span: &gauge.Span{
+			Start: 2,
+			End:   3,
+		},
+	}
+
+	info := &gauge_messages.ExecutionInfo{
+		CurrentSpec: &gauge_messages.SpecInfo{
+			Name:     "Specification",
+			FileName: "file",
+		},
+		CurrentScenario: &gauge_messages.ScenarioInfo{
+			Name:     "Scenario",
+			IsFailed: true,
+		},
+	}
+
+	expected := `{"type":"scenarioEnd","id":"file:2","parentId":"file","name":"Scenario","filename":"file","line":2,"result":{"status":"quarantined","time":0}}
+`
+
+	jc.ScenarioEnd(scenario, &result.ScenarioResult{ProtoScenario: protoScenario, Quarantined: true}, info)
+	c.Assert(dw.output, Equals, expected)
+}
@@ -161,6 +161,7 @@ func ListenExecutionEvents(wg *sync.WaitGroup) {
 			case event.SuiteEnd:
 				r.SuiteEnd(e.Result)
 				wg.Done()
+				return
 			}
 		}
 	}()
@@ -73,6 +73,10 @@ func (sc *simpleConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result
 	defer sc.mu.Unlock()
 	printHookFailureSC(sc, res, res.GetPreHook)
 	printHookFailureSC(sc, res, res.GetPostHook)
+	if isQuarantinedFailure(res) {
+		logger.Info(false, quarantinedMessage)
+		_, _ = fmt.Fprintf(sc.writer, "%s%s", indent(quarantinedMessage, sc.indentation), newline)
+	}
 	sc.indentation -= scenarioIndentation
 }
 
@@ -74,6 +74,10 @@ func (c *verboseColoredConsole) ScenarioEnd(scenario *gauge.Scenario, res result
 	}
 	printHookFailureVCC(c, res, res.GetPreHook)
 	printHookFailureVCC(c, res, res.GetPostHook)
+	if isQuarantinedFailure(res) {
+		logger.Info(false, quarantinedMessage)
+		c.displayMessage(indent(quarantinedMessage, c.indentation)+newline, ct.Magenta)
+	}
 
 	c.writer.Reset()
 	c.indentation -= scenarioIndentation
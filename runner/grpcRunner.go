@@ -29,6 +29,8 @@ import (
 const (
 	host  = "127.0.0.1"
 	oneGB = 1024 * 1024 * 1024
+	// exitWaitTimeout is the time given to a runner which lost its connection to exit, before reporting why it crashed.
+	exitWaitTimeout = 2 * time.Second
 )
 
 // GrpcRunner handles grpc messages.
@@ -40,6 +42,8 @@ type GrpcRunner struct {
 	Timeout      time.Duration
 	info         *RunnerInfo
 	IsExecuting  bool
+	exited       chan struct{}
+	stderr       *outputTail
 }
 
 //nolint:staticcheck
@@ -176,8 +180,10 @@ func (r *GrpcRunner) invokeRPC(message *gm.Message, resChan chan *gm.Message, er
 }
 
 func (r *GrpcRunner) executeMessage(message *gm.Message, timeout time.Duration) (*gm.Message, error) {
-	resChan := make(chan *gm.Message)
-	errChan := make(chan error)
+	// The channels are buffered so that a late response or timer does not block forever
+	// once the caller has stopped waiting.
+	resChan := make(chan *gm.Message, 1)
+	errChan := make(chan error, 2)
 	go r.invokeRPC(message, resChan, errChan)
 
 	timer := setupTimer(timeout, errChan, message.GetMessageType().String())
@@ -239,6 +245,30 @@ func (r *GrpcRunner) Alive() bool {
 	return ps == nil || !ps.Exited()
 }
 
+// Crashed tells if the runner process has exited, or the connection to it is lost.
+func (r *GrpcRunner) Crashed() bool {
+	select {
+	case <-r.exited:
+		return true
+	default:
+		return r.Info().Killed
+	}
+}
+
+// ExitReason describes how the runner process exited, along with the last lines it wrote to stderr.
+func (r *GrpcRunner) ExitReason() string {
+	reason := fmt.Sprintf("Runner with pid %d is not responding", r.Pid())
+	select {
+	case <-r.exited:
+		reason = fmt.Sprintf("Runner with pid %d quit unexpectedly(%s)", r.Pid(), r.cmd.ProcessState.String())
+	case <-time.After(exitWaitTimeout):
+	}
+	if tail := r.stderr.String(); tail != "" {
+		reason = fmt.Sprintf("%s. Last lines of stderr:\n%s", reason, tail)
+	}
+	return reason
+}
+
 // Kill closes the grpc connection and kills the process
 func (r *GrpcRunner) Kill() error {
 	if r.IsExecuting {
@@ -309,8 +339,10 @@ func (r *GrpcRunner) Pid() int {
 func StartGrpcRunner(m *manifest.Manifest, stdout, stderr io.Writer, timeout time.Duration, shouldWriteToStdout bool) (*GrpcRunner, error) {
 	portChan := make(chan string)
 	errChan := make(chan error)
+	exited := make(chan struct{})
+	stderrTail := newOutputTail(outputTailLines)
 	logWriter := &logger.LogWriter{
-		Stderr: logger.NewCustomWriter(portChan, stderr, m.Language, true),
+		Stderr: io.MultiWriter(stderrTail, logger.NewCustomWriter(portChan, stderr, m.Language, true)),
 		Stdout: logger.NewCustomWriter(portChan, stdout, m.Language, false),
 	}
 	cmd, info, err := runRunnerCommand(m, "0", false, logWriter)
@@ -320,6 +352,8 @@ func StartGrpcRunner(m *manifest.Manifest, stdout, stderr io.Writer, timeout tim
 
 	go func() {
 		err = cmd.Wait()
+		processExited(cmd.Process)
+		close(exited)
 		if err != nil {
 			e := fmt.Errorf("Error occurred while waiting for runner process to finish.\nError : %w", err)
 			logger.Error(true, e.Error())
@@ -345,7 +379,7 @@ func StartGrpcRunner(m *manifest.Manifest, stdout, stderr io.Writer, timeout tim
 	if err != nil {
 		return nil, err
 	}
-	r := &GrpcRunner{cmd: cmd, conn: conn, Timeout: timeout, info: info}
+	r := &GrpcRunner{cmd: cmd, conn: conn, Timeout: timeout, info: info, exited: exited, stderr: stderrTail}
 
 	if info.GRPCSupport {
 		r.RunnerClient = gm.NewRunnerClient(conn)
@@ -365,7 +399,8 @@ func setupTimer(timeout time.Duration, errChan chan error, messageType string) *
 }
 
 func stopTimer(timer *time.Timer) {
-	if timer != nil && !timer.Stop() {
-		<-timer.C
+	// Timers created by time.AfterFunc have no channel to drain.
+	if timer != nil {
+		timer.Stop()
 	}
 }
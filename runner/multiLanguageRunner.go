@@ -0,0 +1,273 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+)
+
+// StepRouter is implemented by the runners which execute every step with the one of several language runners implementing it.
+type StepRouter interface {
+	// Implementations gives the languages of the runners implementing the step.
+	Implementations(stepValue string) []string
+}
+
+// RunnerGroup is implemented by the runners which drive the processes of several runners.
+type RunnerGroup interface {
+	// Runners gives the runners of the group.
+	Runners() []Runner
+}
+
+var stepParam = regexp.MustCompile(`<[^>]*>`)
+
+// MultiLanguageRunner drives the runners of a project whose steps are implemented in several languages.
+// Steps are executed by the runner implementing them, while hooks and other messages are sent to every runner.
+type MultiLanguageRunner struct {
+	runners   []Runner
+	languages []string
+	// implementations holds the indexes of the runners implementing each step value.
+	implementations map[string][]int
+}
+
+// StartMultiLanguageRunner starts a runner for each language of the project and asks them for the steps they implement.
+func StartMultiLanguageRunner(m *manifest.Manifest, stream int, killChannel chan bool, debug bool) (*MultiLanguageRunner, error) {
+	r := &MultiLanguageRunner{}
+	for _, language := range m.AllLanguages() {
+		lr, err := Start(m.ForLanguage(language), stream, killChannel, debug)
+		if err != nil {
+			_ = r.Kill()
+			return nil, fmt.Errorf("failed to start %s runner. %w", language, err)
+		}
+		r.runners = append(r.runners, lr)
+		r.languages = append(r.languages, language)
+	}
+	if err := r.loadImplementations(); err != nil {
+		_ = r.Kill()
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *MultiLanguageRunner) loadImplementations() error {
+	r.implementations = make(map[string][]int)
+	m := &gm.Message{MessageType: gm.Message_StepNamesRequest, StepNamesRequest: &gm.StepNamesRequest{}}
+	for i, lr := range r.runners {
+		res, err := lr.ExecuteMessageWithTimeout(m)
+		if err != nil {
+			return fmt.Errorf("failed to get steps from %s runner. %w", r.languages[i], err)
+		}
+		for _, step := range res.GetStepNamesResponse().GetSteps() {
+			value := stepValue(step)
+			if !containsIndex(r.implementations[value], i) {
+				r.implementations[value] = append(r.implementations[value], i)
+			}
+		}
+	}
+	logger.Debugf(true, "Found %d steps implemented across %s runners", len(r.implementations), strings.Join(r.languages, ", "))
+	return nil
+}
+
+// stepValue converts the step text of an implementation to a step value, with placeholders for the parameters.
+func stepValue(stepText string) string {
+	return stepParam.ReplaceAllString(strings.TrimSpace(stepText), gauge.ParameterPlaceholder)
+}
+
+func containsIndex(indexes []int, i int) bool {
+	for _, j := range indexes {
+		if i == j {
+			return true
+		}
+	}
+	return false
+}
+
+// Implementations gives the languages of the runners implementing the step.
+func (r *MultiLanguageRunner) Implementations(stepValue string) []string {
+	var languages []string
+	for _, i := range r.implementations[stepValue] {
+		languages = append(languages, r.languages[i])
+	}
+	return languages
+}
+
+// runnerFor gives the runner implementing the step, or the first runner if none does, so that it reports the missing implementation.
+func (r *MultiLanguageRunner) runnerFor(stepValue string) Runner {
+	if indexes := r.implementations[stepValue]; len(indexes) > 0 {
+		return r.runners[indexes[0]]
+	}
+	return r.runners[0]
+}
+
+// routedStep gives the step value of the messages which are meant for the runner implementing the step.
+func routedStep(m *gm.Message) (string, bool) {
+	switch m.MessageType {
+	case gm.Message_ExecuteStep:
+		return m.GetExecuteStepRequest().GetParsedStepText(), true
+	case gm.Message_StepValidateRequest:
+		return m.GetStepValidateRequest().GetStepText(), true
+	case gm.Message_StepNameRequest:
+		return m.GetStepNameRequest().GetStepValue(), true
+	case gm.Message_RefactorRequest:
+		return m.GetRefactorRequest().GetOldStepValue().GetStepValue(), true
+	}
+	return "", false
+}
+
+func (r *MultiLanguageRunner) ExecuteAndGetStatus(m *gm.Message) *gm.ProtoExecutionResult {
+	if step, ok := routedStep(m); ok {
+		return r.runnerFor(step).ExecuteAndGetStatus(m)
+	}
+	var results []*gm.ProtoExecutionResult
+	for _, lr := range r.runners {
+		results = append(results, lr.ExecuteAndGetStatus(m))
+	}
+	return mergeExecutionResults(results)
+}
+
+// mergeExecutionResults combines the results of the runners for the same message. It fails if any of them failed.
+func mergeExecutionResults(results []*gm.ProtoExecutionResult) *gm.ProtoExecutionResult {
+	merged := &gm.ProtoExecutionResult{}
+	for _, res := range results {
+		if res == nil {
+			continue
+		}
+		merged.ExecutionTime += res.GetExecutionTime()
+		merged.Message = append(merged.Message, res.GetMessage()...)
+		merged.ScreenshotFiles = append(merged.ScreenshotFiles, res.GetScreenshotFiles()...)
+		merged.Screenshots = append(merged.Screenshots, res.GetScreenshots()...)
+		merged.SkipScenario = merged.SkipScenario || res.GetSkipScenario()
+		if res.GetFailed() && !merged.Failed {
+			merged.Failed = true
+			merged.RecoverableError = res.GetRecoverableError()
+			merged.ErrorMessage = res.GetErrorMessage()
+			merged.StackTrace = res.GetStackTrace()
+			merged.ErrorType = res.GetErrorType()
+			merged.FailureScreenshot = res.GetFailureScreenshot()
+			merged.FailureScreenshotFile = res.GetFailureScreenshotFile()
+		}
+	}
+	return merged
+}
+
+func (r *MultiLanguageRunner) ExecuteMessageWithTimeout(m *gm.Message) (*gm.Message, error) {
+	if step, ok := routedStep(m); ok {
+		return r.runnerFor(step).ExecuteMessageWithTimeout(m)
+	}
+	var responses []*gm.Message
+	for i, lr := range r.runners {
+		res, err := lr.ExecuteMessageWithTimeout(m)
+		if err != nil {
+			return nil, fmt.Errorf("%s runner: %w", r.languages[i], err)
+		}
+		responses = append(responses, res)
+	}
+	return mergeResponses(responses), nil
+}
+
+// mergeResponses combines the responses of the runners which list steps, step positions or implementation files.
+// For any other message, the response of the first runner is given.
+func mergeResponses(responses []*gm.Message) *gm.Message {
+	merged := responses[0]
+	switch {
+	case merged.GetStepNamesResponse() != nil:
+		steps := &gm.StepNamesResponse{}
+		for _, res := range responses {
+			steps.Steps = append(steps.Steps, res.GetStepNamesResponse().GetSteps()...)
+		}
+		return &gm.Message{MessageType: merged.MessageType, StepNamesResponse: steps}
+	case merged.GetStepPositionsResponse() != nil:
+		positions := &gm.StepPositionsResponse{}
+		for _, res := range responses {
+			positions.StepPositions = append(positions.StepPositions, res.GetStepPositionsResponse().GetStepPositions()...)
+			if positions.Error == "" {
+				positions.Error = res.GetStepPositionsResponse().GetError()
+			}
+		}
+		return &gm.Message{MessageType: merged.MessageType, StepPositionsResponse: positions}
+	case merged.GetImplementationFileListResponse() != nil:
+		files := &gm.ImplementationFileListResponse{}
+		for _, res := range responses {
+			files.ImplementationFilePaths = append(files.ImplementationFilePaths, res.GetImplementationFileListResponse().GetImplementationFilePaths()...)
+		}
+		return &gm.Message{MessageType: merged.MessageType, ImplementationFileListResponse: files}
+	}
+	return merged
+}
+
+// Alive checks if all the runner processes are alive.
+func (r *MultiLanguageRunner) Alive() bool {
+	for _, lr := range r.runners {
+		if !lr.Alive() {
+			return false
+		}
+	}
+	return true
+}
+
+// Kill kills all the runners.
+func (r *MultiLanguageRunner) Kill() error {
+	var err error
+	for i, lr := range r.runners {
+		if e := lr.Kill(); e != nil && err == nil {
+			err = fmt.Errorf("failed to kill %s runner. %w", r.languages[i], e)
+		}
+	}
+	return err
+}
+
+// Crashed tells if any of the runners crashed.
+func (r *MultiLanguageRunner) Crashed() bool {
+	for _, lr := range r.runners {
+		if c, ok := lr.(CrashReporter); ok && c.Crashed() {
+			return true
+		}
+	}
+	return false
+}
+
+// ExitReason describes how the crashed runners exited.
+func (r *MultiLanguageRunner) ExitReason() string {
+	var reasons []string
+	for i, lr := range r.runners {
+		if c, ok := lr.(CrashReporter); ok && c.Crashed() {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", r.languages[i], c.ExitReason()))
+		}
+	}
+	return strings.Join(reasons, "\n")
+}
+
+func (r *MultiLanguageRunner) Connection() net.Conn {
+	return nil
+}
+
+// IsMultithreaded is false, as multithreaded execution needs a single runner serving all the streams.
+func (r *MultiLanguageRunner) IsMultithreaded() bool {
+	return false
+}
+
+// Runners gives the runners of all the languages of the project.
+func (r *MultiLanguageRunner) Runners() []Runner {
+	return r.runners
+}
+
+// Info gives the information about the runner of the first language of the project.
+func (r *MultiLanguageRunner) Info() *RunnerInfo {
+	return r.runners[0].Info()
+}
+
+// Pid gives the process id of the runner of the first language of the project.
+func (r *MultiLanguageRunner) Pid() int {
+	return r.runners[0].Pid()
+}
@@ -0,0 +1,105 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+)
+
+type fakeRunner struct {
+	steps    []string
+	failHook bool
+	received []gm.Message_MessageType
+}
+
+func (r *fakeRunner) ExecuteAndGetStatus(m *gm.Message) *gm.ProtoExecutionResult {
+	r.received = append(r.received, m.MessageType)
+	if r.failHook && m.MessageType == gm.Message_ScenarioExecutionStarting {
+		return &gm.ProtoExecutionResult{Failed: true, ErrorMessage: "hook failed", ExecutionTime: 2}
+	}
+	return &gm.ProtoExecutionResult{ExecutionTime: 1, Message: []string{"ok"}}
+}
+
+func (r *fakeRunner) ExecuteMessageWithTimeout(m *gm.Message) (*gm.Message, error) {
+	r.received = append(r.received, m.MessageType)
+	return &gm.Message{MessageType: gm.Message_StepNamesResponse, StepNamesResponse: &gm.StepNamesResponse{Steps: r.steps}}, nil
+}
+
+func (r *fakeRunner) Alive() bool           { return true }
+func (r *fakeRunner) Kill() error           { return nil }
+func (r *fakeRunner) Connection() net.Conn  { return nil }
+func (r *fakeRunner) IsMultithreaded() bool { return false }
+func (r *fakeRunner) Info() *RunnerInfo     { return &RunnerInfo{} }
+func (r *fakeRunner) Pid() int              { return -1 }
+
+func multiLanguageRunner(t *testing.T, runners ...*fakeRunner) *MultiLanguageRunner {
+	r := &MultiLanguageRunner{}
+	for i, lr := range runners {
+		r.runners = append(r.runners, lr)
+		r.languages = append(r.languages, []string{"java", "js", "python"}[i])
+	}
+	if err := r.loadImplementations(); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestMultiLanguageRunnerRoutesStepToImplementingRunner(t *testing.T) {
+	java := &fakeRunner{steps: []string{"call api <endpoint>"}}
+	js := &fakeRunner{steps: []string{"open browser at <url>"}}
+	r := multiLanguageRunner(t, java, js)
+	java.received, js.received = nil, nil
+
+	r.ExecuteAndGetStatus(&gm.Message{MessageType: gm.Message_ExecuteStep, ExecuteStepRequest: &gm.ExecuteStepRequest{ParsedStepText: "open browser at {}"}})
+
+	if len(java.received) != 0 || len(js.received) != 1 {
+		t.Errorf("Expected step to be executed only by js runner. java got %v, js got %v", java.received, js.received)
+	}
+}
+
+func TestMultiLanguageRunnerSendsHooksToAllRunners(t *testing.T) {
+	java := &fakeRunner{}
+	js := &fakeRunner{failHook: true}
+	r := multiLanguageRunner(t, java, js)
+
+	res := r.ExecuteAndGetStatus(&gm.Message{MessageType: gm.Message_ScenarioExecutionStarting})
+
+	if !res.GetFailed() || res.GetErrorMessage() != "hook failed" || res.GetExecutionTime() != 3 {
+		t.Errorf("Expected hook results to be merged. Got %v", res)
+	}
+	if !reflect.DeepEqual(res.GetMessage(), []string{"ok"}) {
+		t.Errorf("Expected messages of all runners. Got %v", res.GetMessage())
+	}
+}
+
+func TestMultiLanguageRunnerGivesImplementationsOfStep(t *testing.T) {
+	r := multiLanguageRunner(t, &fakeRunner{steps: []string{"login as <user>", "logout"}}, &fakeRunner{steps: []string{"login as <name>"}})
+
+	if got := r.Implementations("login as {}"); !reflect.DeepEqual(got, []string{"java", "js"}) {
+		t.Errorf("Expected step to be implemented in java and js. Got %v", got)
+	}
+	if got := r.Implementations("logout"); !reflect.DeepEqual(got, []string{"java"}) {
+		t.Errorf("Expected step to be implemented in java. Got %v", got)
+	}
+}
+
+func TestMultiLanguageRunnerMergesStepNames(t *testing.T) {
+	r := multiLanguageRunner(t, &fakeRunner{steps: []string{"a"}}, &fakeRunner{steps: []string{"b"}})
+
+	res, err := r.ExecuteMessageWithTimeout(&gm.Message{MessageType: gm.Message_StepNamesRequest, StepNamesRequest: &gm.StepNamesRequest{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := res.GetStepNamesResponse().GetSteps(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Expected steps of all runners. Got %v", got)
+	}
+}
@@ -0,0 +1,53 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"strings"
+	"sync"
+)
+
+const outputTailLines = 20
+
+// outputTail keeps the last lines written to it, so that the output of a runner process can be reported when it crashes.
+type outputTail struct {
+	mutex   sync.Mutex
+	size    int
+	lines   []string
+	partial string
+}
+
+func newOutputTail(size int) *outputTail {
+	return &outputTail{size: size}
+}
+
+func (t *outputTail) Write(p []byte) (int, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	lines := strings.Split(t.partial+string(p), "\n")
+	t.partial = lines[len(lines)-1]
+	for _, l := range lines[:len(lines)-1] {
+		t.lines = append(t.lines, strings.TrimRight(l, "\r"))
+	}
+	if len(t.lines) > t.size {
+		t.lines = t.lines[len(t.lines)-t.size:]
+	}
+	return len(p), nil
+}
+
+func (t *outputTail) String() string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	lines := t.lines
+	if t.partial != "" {
+		lines = append(lines[:len(lines):len(lines)], t.partial)
+	}
+	if len(lines) > t.size {
+		lines = lines[len(lines)-t.size:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,28 @@
+//go:build !windows
+
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// detachFromTerminalSignals starts the command in its own process group, so that the interrupt from the terminal
+// reaches only gauge, which stops the runner once the execution is wound up. Where supported, the runner is killed
+// when gauge dies without stopping it, as it no longer gets the signals sent to the process group of gauge.
+func detachFromTerminalSignals(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	killOnParentExit(cmd.SysProcAttr)
+}
+
+// killProcessGroup kills the process along with the processes it started, which are in its process group.
+func killProcessGroup(p *os.Process) error {
+	return syscall.Kill(-p.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,14 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import "syscall"
+
+// killOnParentExit has the kernel kill the process when gauge exits, even when gauge is killed or crashes.
+func killOnParentExit(attr *syscall.SysProcAttr) {
+	attr.Pdeathsig = syscall.SIGKILL
+}
@@ -0,0 +1,23 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+)
+
+func TestDetachFromTerminalSignalsKillsRunnerWhenGaugeExits(t *testing.T) {
+	cmd := exec.Command("true")
+
+	detachFromTerminalSignals(cmd)
+
+	if !cmd.SysProcAttr.Setpgid || cmd.SysProcAttr.Pdeathsig != syscall.SIGKILL {
+		t.Errorf("Expected runner in its own process group to be killed when gauge exits, got %+v", cmd.SysProcAttr)
+	}
+}
@@ -0,0 +1,15 @@
+//go:build !windows && !linux
+
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import "syscall"
+
+// killOnParentExit is not supported on this platform. The runners are killed by gauge on the signals it handles.
+func killOnParentExit(_ *syscall.SysProcAttr) {
+}
@@ -0,0 +1,49 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"os"
+	"sync"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+// processes holds the runner processes which are running, so that they can be killed when gauge exits abruptly.
+var processes = struct {
+	sync.Mutex
+	running map[*os.Process]bool
+}{running: make(map[*os.Process]bool)}
+
+func init() {
+	logger.OnFatal(KillAll)
+}
+
+func processStarted(p *os.Process) {
+	processes.Lock()
+	defer processes.Unlock()
+	processes.running[p] = true
+}
+
+func processExited(p *os.Process) {
+	processes.Lock()
+	defer processes.Unlock()
+	delete(processes.running, p)
+}
+
+// KillAll kills all the runner processes which are running, along with the processes they started.
+// Runners are started in their own process groups and do not get the signals from the terminal,
+// so they are to be killed by gauge before exiting without waiting for them to stop, including on fatal errors.
+func KillAll() {
+	processes.Lock()
+	defer processes.Unlock()
+	for p := range processes.running {
+		if err := killProcessGroup(p); err != nil {
+			logger.Debugf(true, "Unable to kill runner with pid %d. %s", p.Pid, err.Error())
+		}
+	}
+}
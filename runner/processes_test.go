@@ -0,0 +1,30 @@
+//go:build !windows
+
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package runner
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestKillAllKillsRunningProcesses(t *testing.T) {
+	cmd := exec.Command("sleep", "60")
+	detachFromTerminalSignals(cmd)
+	if err := cmd.Start(); err != nil {
+		t.Skipf("Unable to start process. %s", err.Error())
+	}
+	processStarted(cmd.Process)
+	defer processExited(cmd.Process)
+
+	KillAll()
+
+	if err := cmd.Wait(); err == nil || cmd.ProcessState.Success() {
+		t.Errorf("Expected process to be killed, got %v", cmd.ProcessState)
+	}
+}
@@ -38,6 +38,12 @@ type Runner interface {
 	Pid() int
 }
 
+// CrashReporter is implemented by the runners which can tell if their process crashed, and why.
+type CrashReporter interface {
+	Crashed() bool
+	ExitReason() string
+}
+
 type RunnerInfo struct {
 	Id          string
 	Name        string
@@ -97,7 +103,16 @@ func runRunnerCommand(manifest *manifest.Manifest, port string, debug bool, writ
 	}
 	command := getOsSpecificCommand(&r)
 	env := getCleanEnv(port, os.Environ(), debug, getPluginPaths())
-	cmd, err := common.ExecuteCommandWithEnv(command, runnerDir, writer.Stdout, writer.Stderr, env)
+	cmd := common.GetExecutableCommand(false, command...)
+	cmd.Dir = runnerDir
+	cmd.Stdout = writer.Stdout
+	cmd.Stderr = writer.Stderr
+	cmd.Env = env
+	detachFromTerminalSignals(cmd)
+	err = cmd.Start()
+	if err == nil {
+		processStarted(cmd.Process)
+	}
 	return cmd, &r, err
 }
 
@@ -127,6 +142,7 @@ func getLanguageJSONFilePath(manifest *manifest.Manifest, r *RunnerInfo) (string
 func (r *LegacyRunner) waitAndGetErrorMessage() {
 	go func() {
 		pState, err := r.Cmd.Process.Wait()
+		processExited(r.Cmd.Process)
 		r.mutex.Lock()
 		r.Cmd.ProcessState = pState
 		r.mutex.Unlock()
@@ -179,6 +195,9 @@ func getOsSpecificCommand(r *RunnerInfo) []string {
 }
 
 func Start(manifest *manifest.Manifest, stream int, killChannel chan bool, debug bool) (Runner, error) {
+	if len(manifest.AllLanguages()) > 1 {
+		return StartMultiLanguageRunner(manifest, stream, killChannel, debug)
+	}
 	ri, err := GetRunnerInfo(manifest.Language)
 	if err == nil && ri.GRPCSupport {
 		return StartGrpcRunner(manifest, os.Stdout, os.Stderr, config.RunnerRequestTimeout(), true)
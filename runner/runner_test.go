@@ -46,3 +46,14 @@ func TestGetCleanEnvAddsToPath(t *testing.T) {
 		t.Errorf("getCleanEnv failed. Did not append to path.\n\tWanted PATH to contain: `%s`", want)
 	}
 }
+
+func TestOutputTailKeepsLastLines(t *testing.T) {
+	tail := newOutputTail(2)
+	_, _ = tail.Write([]byte("first\nsecond\r\nthi"))
+	_, _ = tail.Write([]byte("rd\nfourth"))
+
+	want := "third\nfourth"
+	if got := tail.String(); got != want {
+		t.Errorf("Want: %q, Got: %q", want, got)
+	}
+}
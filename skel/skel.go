@@ -34,6 +34,13 @@ csv_delimiter = ,
 
 # Allows steps to be written in multiline
 allow_multiline_step = false
+
+# Maximum time a step or a scenario may take to execute, e.g. 30s or 5m. A plain number is read as milliseconds.
+# No limit is applied when not set. The runner is restarted after a timeout, as it may still be executing the step,
+# unless it is shared by parallel streams or the --max-runner-restarts limit is reached.
+# These can be overridden for a spec or scenario using the tags step-timeout:<duration> and timeout:<duration>.
+# step_timeout = 30s
+# scenario_timeout = 5m
 `
 var ExampleSpec = `# Specification Heading
 
@@ -138,6 +138,27 @@ func FindAllNestedDirs(dir string) []string {
 	return nestedDirs
 }
 
+// FindProjectDirs returns the given directory and all its nested directories,
+// except the hidden ones and the ones ignored by gauge like reports and logs.
+func FindProjectDirs(dir string) []string {
+	addIgnoredDirectories()
+	var dirs []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+		if path != dir && (strings.HasPrefix(info.Name(), ".") || ignoredDirectories[path]) {
+			return filepath.SkipDir
+		}
+		dirs = append(dirs, path)
+		return nil
+	})
+	if err != nil {
+		logger.Errorf(false, "Failed to find nested directories for %s: %s", dir, err.Error())
+	}
+	return dirs
+}
+
 // IsDir reports whether path describes a directory.
 func IsDir(path string) bool {
 	fileInfo, err := os.Stat(path)
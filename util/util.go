@@ -8,6 +8,7 @@ package util
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -137,3 +138,18 @@ func ListContains(list []string, val string) bool {
 func GetFileContents(filepath string) (string, error) {
 	return common.ReadFileContents(GetPathToFile(filepath))
 }
+
+// ListenAddress gives the address to listen at for the given address, which is on the loopback interface if no host is
+// given, like :9000. It also tells if the address is reachable from the local machine only.
+func ListenAddress(address string) (string, bool, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", false, err
+	}
+	if host == "" {
+		host = "127.0.0.1"
+	}
+	ip := net.ParseIP(host)
+	local := host == "localhost" || (ip != nil && ip.IsLoopback())
+	return net.JoinHostPort(host, port), local, nil
+}
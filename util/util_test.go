@@ -21,3 +21,21 @@ func (s *MySuite) TestConceptsDirEnvVariableAllowsCommaSeparatedList(c *C) {
 	c.Assert(e, Equals, nil)
 	c.Assert(GetConceptsPaths(), DeepEquals, []string{"dir1", "dir2", "dir3"})
 }
+
+func (s *MySuite) TestListenAddressIsOnLoopbackWhenNoHostIsGiven(c *C) {
+	address, local, err := ListenAddress(":9000")
+	c.Assert(err, IsNil)
+	c.Assert(address, Equals, "127.0.0.1:9000")
+	c.Assert(local, Equals, true)
+
+	address, local, err = ListenAddress("0.0.0.0:9000")
+	c.Assert(err, IsNil)
+	c.Assert(address, Equals, "0.0.0.0:9000")
+	c.Assert(local, Equals, false)
+
+	_, local, _ = ListenAddress("localhost:9000")
+	c.Assert(local, Equals, true)
+
+	_, _, err = ListenAddress("9000")
+	c.Assert(err, NotNil)
+}
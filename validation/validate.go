@@ -26,7 +26,9 @@ import (
 	"github.com/getgauge/gauge/api"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/util"
 )
@@ -162,6 +164,18 @@ func NewValidationResult(s *gauge.SpecCollection, errMap *gauge.BuildErrors, r r
 
 // ValidateSpecs parses the specs, creates a new validator and call the runner to get the validation result.
 func ValidateSpecs(specsToValidate []string, debug bool) *ValidationResult {
+	return validateSpecs(specsToValidate, func() runner.Runner { return startAPI(debug) }, true)
+}
+
+// ValidateSpecsWithRunner validates the specs using an already started runner. The runner is left running even if parsing fails.
+func ValidateSpecsWithRunner(specsToValidate []string, r runner.Runner) *ValidationResult {
+	return validateSpecs(specsToValidate, func() runner.Runner { return r }, false)
+}
+
+func validateSpecs(specsToValidate []string, getRunner func() runner.Runner, killOnParseFailure bool) *ValidationResult {
+	if m, err := manifest.ProjectManifest(); err == nil {
+		logger.HandleWarningMessages(true, plugin.RegisterSpecialParams(m))
+	}
 	logger.Debug(true, "Parsing started.")
 	conceptDict, res, err := parser.ParseConcepts()
 	if err != nil {
@@ -170,16 +184,17 @@ func ValidateSpecs(specsToValidate []string, debug bool) *ValidationResult {
 	errMap := gauge.NewBuildErrors()
 	specs, specsFailed := parser.ParseSpecs(specsToValidate, conceptDict, errMap)
 	logger.Debug(true, "Parsing completed.")
-	r := startAPI(debug)
+	r := getRunner()
 	validationErrors := NewValidator(specs, r, conceptDict).Validate()
 	errMap = getErrMap(errMap, validationErrors)
 	specs = parser.GetSpecsForDataTableRows(specs, errMap)
 	printValidationFailures(validationErrors)
 	showSuggestion(validationErrors)
 	if !res.Ok {
-		err := r.Kill()
-		if err != nil {
-			logger.Errorf(true, "unable to kill runner: %s", err.Error())
+		if killOnParseFailure {
+			if err := r.Kill(); err != nil {
+				logger.Errorf(true, "unable to kill runner: %s", err.Error())
+			}
 		}
 		return NewValidationResult(nil, nil, nil, false, errors.New("Parsing failed"))
 	}
@@ -286,6 +301,9 @@ func (v *validator) Validate() validationErrors {
 			validationStatus[spec] = validationErrors
 		}
 	}
+	for spec, errs := range validateTableRowsFilter(v.specsToExecute) {
+		validationStatus[spec] = append(validationStatus[spec], errs...)
+	}
 	if len(validationStatus) > 0 {
 		return validationStatus
 	}
@@ -332,11 +350,20 @@ func (v *SpecValidator) Step(s *gauge.Step) {
 
 var invalidResponse gm.StepValidateResponse_ErrorType = -1
 
+var duplicateImplementation = gm.StepValidateResponse_DUPLICATE_STEP_IMPLEMENTATION
+
 func (v *SpecValidator) validateStep(s *gauge.Step) error {
 	stepValue, err := parser.ExtractStepValueAndParams(s.LineText, s.HasInlineTable)
 	if err != nil {
 		return nil
 	}
+	if languages := implementations(v.runner, s.Value); len(languages) > 1 {
+		msg := fmt.Sprintf("Step is implemented in more than one language: %s", strings.Join(languages, ", "))
+		if s.Parent == nil {
+			return NewStepValidationError(s, msg, v.specification.FileName, &duplicateImplementation, "")
+		}
+		return NewStepValidationError(s, msg, v.conceptsDictionary.Search(s.Parent.Value).FileName, &duplicateImplementation, "")
+	}
 	protoStepValue := gauge.ConvertToProtoStepValue(stepValue)
 
 	m := &gm.Message{MessageType: gm.Message_StepValidateRequest,
@@ -365,6 +392,14 @@ func (v *SpecValidator) validateStep(s *gauge.Step) error {
 	return NewStepValidationError(s, "Invalid response from runner for Validation request", v.specification.FileName, &invalidResponse, "")
 }
 
+// implementations gives the languages implementing the step, when the steps of the project are implemented in several languages.
+func implementations(r runner.Runner, stepValue string) []string {
+	if sr, ok := r.(runner.StepRouter); ok {
+		return sr.Implementations(stepValue)
+	}
+	return nil
+}
+
 func getMessage(message string) string {
 	lower := strings.ToLower(strings.ReplaceAll(message, "_", " "))
 	return strings.ToUpper(lower[:1]) + lower[1:]
@@ -407,6 +442,12 @@ func validateDataTableRange(rowCount int) error {
 	if TableRows == "" {
 		return nil
 	}
+	if gauge.IsTableRowFilter(TableRows) {
+		if _, err := gauge.ParseTableRowFilter(TableRows); err != nil {
+			return fmt.Errorf("Table rows filter '%s' is invalid => %s", TableRows, err.Error())
+		}
+		return nil
+	}
 	if strings.Contains(TableRows, "-") {
 		indexes := strings.Split(TableRows, "-")
 		if len(indexes) > 2 {
@@ -429,6 +470,51 @@ func validateDataTableRange(rowCount int) error {
 	return nil
 }
 
+// validateTableRowsFilter fails the specs having data tables, if the table rows filter has a column which none of the
+// data tables of the specs has. Otherwise the rows would be selected as if the condition on the column was not given.
+func validateTableRowsFilter(specs []*gauge.Specification) validationErrors {
+	if !gauge.IsTableRowFilter(TableRows) {
+		return nil
+	}
+	f, err := gauge.ParseTableRowFilter(TableRows)
+	if err != nil {
+		// the invalid filter is reported for every spec.
+		return nil
+	}
+	var tables []*gauge.Table
+	var specsWithTables []*gauge.Specification
+	for _, spec := range specs {
+		specTables := dataTables(spec)
+		if len(specTables) > 0 {
+			tables = append(tables, specTables...)
+			specsWithTables = append(specsWithTables, spec)
+		}
+	}
+	unknown := f.UnknownColumns(tables...)
+	if len(unknown) == 0 {
+		return nil
+	}
+	errs := make(validationErrors)
+	for _, spec := range specsWithTables {
+		message := fmt.Sprintf("Table rows filter '%s' is invalid => None of the data tables has the column '%s'", TableRows, strings.Join(unknown, "', '"))
+		errs[spec] = []error{NewSpecValidationError(message, spec.FileName)}
+	}
+	return errs
+}
+
+func dataTables(spec *gauge.Specification) []*gauge.Table {
+	var tables []*gauge.Table
+	if spec.DataTable.Table.IsInitialized() {
+		tables = append(tables, spec.DataTable.Table)
+	}
+	for _, scenario := range spec.Scenarios {
+		if scenario.DataTable.Table.IsInitialized() {
+			tables = append(tables, scenario.DataTable.Table)
+		}
+	}
+	return tables
+}
+
 func validateTableRow(rowNumber string, rowCount int) error {
 	if rowNumber = strings.TrimSpace(rowNumber); rowNumber == "" {
 		return fmt.Errorf("Table rows range validation failed => Row number cannot be empty")
@@ -145,6 +145,25 @@ func (s *MySuite) TestValidateStep(c *C) {
 		"}")
 }
 
+func (s *MySuite) TestValidateStepImplementedInMoreThanOneLanguage(c *C) {
+	myStep := &gauge.Step{Value: "my step", LineText: "my step", IsConcept: false, LineNo: 3}
+	runner := &multiLanguageMockRunner{
+		mockRunner: mockRunner{
+			ExecuteMessageFunc: func(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+				c.Errorf("Expected ambiguous step not to be validated by the runners")
+				return nil, nil
+			},
+		},
+		implementations: map[string][]string{"my step": {"java", "js"}},
+	}
+	specVal := &SpecValidator{specification: &gauge.Specification{FileName: "foo.spec"}, runner: runner}
+	valErr := specVal.validateStep(myStep)
+
+	c.Assert(valErr, Not(Equals), nil)
+	c.Assert(valErr.Error(), Equals, "foo.spec:3 Step is implemented in more than one language: java, js => 'my step'")
+	c.Assert(valErr.(StepValidationError).ErrorType(), Equals, gauge_messages.StepValidateResponse_DUPLICATE_STEP_IMPLEMENTATION)
+}
+
 func (s *MySuite) TestShouldNotGiveSuggestionWhenHideSuggestionFlagIsFalse(c *C) {
 	HideSuggestion = true
 	myStep := &gauge.Step{Value: "my step", LineText: "my step", IsConcept: false, LineNo: 3}
@@ -260,6 +279,9 @@ var tableRowTests = []*tableRow{
 	{"Row count is zero with non empty input", "1", 0, errors.New("Table rows range validation failed => Table row number '1' is out of range")},
 	{"Row count is non-zero with empty input", "", 2, nil},
 	{"Row count is non-zero with non-empty input", "2", 2, nil},
+	{"Valid table rows filter", "country=IN && tier!=gold || region=us-east", 0, nil},
+	{"Table rows filter without column", "=IN", 2, errors.New("Table rows filter '=IN' is invalid => condition '=IN' has no column name")},
+	{"Table rows filter with condition without value", "country=IN && gold", 2, errors.New("Table rows filter 'country=IN && gold' is invalid => condition 'gold' should be of format column=value or column!=value")},
 }
 
 func (s *MySuite) TestToValidateDataTableRowsRangeFromInputFlag(c *C) {
@@ -271,6 +293,23 @@ func (s *MySuite) TestToValidateDataTableRowsRangeFromInputFlag(c *C) {
 	}
 }
 
+func (s *MySuite) TestValidateTableRowsFilterFailsSpecsWithDataTablesWhenNoneHasTheColumn(c *C) {
+	TableRows = "country=IN && tier=gold"
+	defer func() { TableRows = "" }()
+	withSpecTable := &gauge.Specification{FileName: "a.spec", DataTable: gauge.DataTable{Table: gauge.NewTable([]string{"country"}, nil, 1)}}
+	withScenarioTable := &gauge.Specification{FileName: "b.spec", Scenarios: []*gauge.Scenario{{DataTable: gauge.DataTable{Table: gauge.NewTable([]string{"browser"}, nil, 3)}}}}
+	withoutTables := &gauge.Specification{FileName: "c.spec"}
+
+	errs := validateTableRowsFilter([]*gauge.Specification{withSpecTable, withScenarioTable, withoutTables})
+
+	c.Assert(len(errs), Equals, 2)
+	c.Assert(errs[withSpecTable], DeepEquals, []error{NewSpecValidationError("Table rows filter 'country=IN && tier=gold' is invalid => None of the data tables has the column 'tier'", "a.spec")})
+	c.Assert(errs[withoutTables], IsNil)
+
+	withScenarioTable.Scenarios[0].DataTable.Table = gauge.NewTable([]string{"tier"}, nil, 3)
+	c.Assert(validateTableRowsFilter([]*gauge.Specification{withSpecTable, withScenarioTable, withoutTables}), IsNil)
+}
+
 type mockRunner struct {
 	ExecuteMessageFunc func(m *gauge_messages.Message) (*gauge_messages.Message, error)
 }
@@ -305,3 +344,12 @@ func (r *mockRunner) Info() *runner.RunnerInfo {
 func (r *mockRunner) Pid() int {
 	return -1
 }
+
+type multiLanguageMockRunner struct {
+	mockRunner
+	implementations map[string][]string
+}
+
+func (r *multiLanguageMockRunner) Implementations(stepValue string) []string {
+	return r.implementations[stepValue]
+}